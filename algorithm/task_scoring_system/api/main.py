@@ -3,7 +3,9 @@
 # ---------------------------------------------------------------------------------  #
 
 # ライブラリのインポート
+import os
 import json
+import requests
 import torch
 import torch.nn.functional as F
 from fastapi import FastAPI, HTTPException
@@ -11,6 +13,10 @@ from pydantic import BaseModel
 import uvicorn
 from common.utils.database import get_connection
 from common.utils.config import device
+from common.utils.preprocess import compute_text_embeddings, compute_image_embeddings
+from common.utils.s3 import get_presigned_url
+from io import BytesIO
+from PIL import Image
 import traceback
 
 # ----------------------------------
@@ -23,11 +29,42 @@ class TaskRequest(BaseModel):
 class ScoreResponse(BaseModel):
     score: float
 
+class EmbedTextRequest(BaseModel):
+    text: str
+
+class EmbedTextResponse(BaseModel):
+    embedding: list[float]
+
 # ----------------------------------
 # FastAPIアプリの構築
 # ----------------------------------
 app = FastAPI()
 
+def embed_post_image(cur, image_key):
+    """
+    S3 の投稿画像から特徴量を算出し、posts.image_feature に保存する関数
+    (投稿直後はバッチの特徴抽出がまだ走っていないため)
+    """
+    image_url = get_presigned_url(
+        bucket_name=os.getenv("AWS_S3_BUCKET_NAME"),
+        object_key=image_key,
+        expiration=3600
+    )
+    response = requests.get(image_url, timeout=10)
+    response.raise_for_status()
+    image = Image.open(BytesIO(response.content)).convert("RGB")
+    image_feature = compute_image_embeddings(image).squeeze(0).tolist()
+
+    cur.execute(
+        """
+        UPDATE posts
+        SET image_feature = %s
+        WHERE image_key = %s
+        """
+        , (json.dumps(image_feature), image_key)
+    )
+    return image_feature
+
 # ----------------------------------
 # /task/score エンドポイント
 # ----------------------------------
@@ -64,9 +101,13 @@ async def calculate_score(request: TaskRequest):
             , (request.image_key, )
         )
         image_feature_json = cur.fetchone()
+        if image_feature_json is None:
+            raise HTTPException(status_code=404, detail="Post is not found")
         image_feature = json.loads(image_feature_json[0]) if isinstance(image_feature_json[0], str) else image_feature_json[0]
         if image_feature is None:
-            raise HTTPException(status_code=404, detail="Image feature is not found")
+            # 特徴抽出前の投稿は画像から直接算出する
+            image_feature = embed_post_image(cur, request.image_key)
+            conn.commit()
         cur.close()
         conn.close()
 
@@ -77,10 +118,28 @@ async def calculate_score(request: TaskRequest):
         score = max(0, min(100, score * 100))  # スコアを0から100の範囲に制限
         return ScoreResponse(score=score)
     
+    except HTTPException:
+        raise
     except Exception as e:
         traceback.print_exc()
         raise HTTPException(status_code=500, detail=str(e))
     
+# ----------------------------------
+# /embed/text エンドポイント
+# ----------------------------------
+@app.post("/embed/text", response_model=EmbedTextResponse)
+async def embed_text(request: EmbedTextRequest):
+    """
+    テキストの埋め込みベクトルを返すエンドポイント(Go API の投稿検索で使用)
+    """
+    try:
+        text_feature = compute_text_embeddings(request.text)
+        return EmbedTextResponse(embedding=text_feature.squeeze(0).tolist())
+
+    except Exception as e:
+        traceback.print_exc()
+        raise HTTPException(status_code=500, detail=str(e))
+
 if __name__ == "__main__":
     uvicorn.run(app, host="0.0.0.0", port=8000)
 
@@ -25,6 +25,8 @@ export class BackendStack extends cdk.Stack {
       // AWS_ACCESS_KEY_ID,
       // AWS_SECRET_ACCESS_KEY,
       AWS_S3_BUCKET_NAME,
+      TASK_SCORING_URL,
+      EMBEDDING_URL,
     } = getRequiredEnvVars([
       "DATABASE_URL",
       "JWT_SECRET",
@@ -35,12 +37,20 @@ export class BackendStack extends cdk.Stack {
       // "AWS_ACCESS_KEY_ID",
       // "AWS_SECRET_ACCESS_KEY",
       "AWS_S3_BUCKET_NAME",
+      "TASK_SCORING_URL",
+      "EMBEDDING_URL",
     ]);
 
     const apiFn = new lambda.Function(this, "AnimaliaBackend", {
       runtime: lambda.Runtime.PROVIDED_AL2023,
       handler: "bootstrap",
       code: lambda.Code.fromAsset(path.join(__dirname, "../../bin/api")),
+      // API Gateway gives up after 29 seconds. The HTTP clients called within a request time out
+      // before that (task scoring after 15 seconds, Expo push after 5 seconds), so the response still reaches the client.
+      timeout: cdk.Duration.seconds(29),
+      // Uploaded images are decoded (HEIC through a WASM decoder), rotated and resized in the request.
+      // A 24 megapixel photo takes a few hundred MB, and more memory also gives the function more CPU.
+      memorySize: 1536,
       environment: {
         DATABASE_URL,
         JWT_SECRET,
@@ -51,6 +61,13 @@ export class BackendStack extends cdk.Stack {
         // AWS_ACCESS_KEY_ID,
         // AWS_SECRET_ACCESS_KEY,
         AWS_S3_BUCKET_NAME,
+        TASK_SCORING_URL,
+        EMBEDDING_URL,
+        PUSH_SENDER: "expo",
+        // Only needed when enhanced push security is enabled for the Expo project
+        ...(process.env.EXPO_ACCESS_TOKEN
+          ? { EXPO_ACCESS_TOKEN: process.env.EXPO_ACCESS_TOKEN }
+          : {}),
       },
     });
 
@@ -71,6 +88,21 @@ export class BackendStack extends cdk.Stack {
       schedule: events.Schedule.cron({ minute: "0", hour: "15", day: "*" }),
       targets: [new targets.LambdaFunction(dailyTaskFn)],
     });
+
+    const postReaperFn = new lambda.Function(this, "PostReaper", {
+      runtime: lambda.Runtime.PROVIDED_AL2023,
+      handler: "bootstrap",
+      code: lambda.Code.fromAsset(path.join(__dirname, "../../bin/postreaper")),
+      environment: {
+        DATABASE_URL,
+        AWS_S3_BUCKET_NAME,
+      },
+    });
+
+    new events.Rule(this, "PostReaperRule", {
+      schedule: events.Schedule.cron({ minute: "30", hour: "15", day: "*" }),
+      targets: [new targets.LambdaFunction(postReaperFn)],
+    });
     // The code that defines your stack goes here
 
     // example resource
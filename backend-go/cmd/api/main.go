@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
 	"github.com/aki-13627/animalia/backend-go/internal/routes"
 	"github.com/aki-13627/animalia/backend-go/internal/seed"
 	"github.com/joho/godotenv"
@@ -31,7 +32,7 @@ func main() {
 	}
 	defer client.Close()
 	// Auto migration
-	if err := client.Schema.Create(context.Background()); err != nil {
+	if err := infra.MigrateSchema(context.Background(), client); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
@@ -100,6 +101,15 @@ func main() {
 	routes.SetupUserRoutes(app)
 	routes.SetupLikeRoutes(app)
 	routes.SetupCommentRoutes(app)
+	routes.SetupTaskRoutes(app)
+	routes.SetupAdminRoutes(app)
+	routes.SetupSearchRoutes(app)
+	routes.SetupUploadRoutes(app)
+	routes.SetupNotificationRoutes(app)
+	routes.SetupPushRoutes(app)
+	routes.SetupFileRoutes(app)
+	routes.SetupTagRoutes(app)
+	routes.SetupStreamRoutes(app)
 	log.Println("API routes setup completed")
 
 	// Get port from environment variable or use default
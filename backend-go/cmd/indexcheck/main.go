@@ -0,0 +1,148 @@
+// indexcheck reports gaps, duplicates and missing values in User.index and Post.index,
+// and whether the index counters are ahead of the allocated indexes.
+// It exits with status 1 when a problem is found.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
+	_ "github.com/lib/pq" // PostgreSQLドライバー
+)
+
+type indexReport struct {
+	name       string
+	total      int
+	missing    int
+	duplicates []int
+	gaps       []int
+	counter    *int
+	next       int
+}
+
+func (r indexReport) ok() bool {
+	return r.missing == 0 && len(r.duplicates) == 0 && len(r.gaps) == 0 &&
+		(r.counter == nil || *r.counter >= r.next)
+}
+
+func (r indexReport) print() {
+	fmt.Printf("[%s] rows: %d, next index: %d\n", r.name, r.total, r.next)
+	if r.missing > 0 {
+		fmt.Printf("  rows without index: %d\n", r.missing)
+	}
+	if len(r.duplicates) > 0 {
+		fmt.Printf("  duplicated indexes: %v\n", r.duplicates)
+	}
+	if len(r.gaps) > 0 {
+		fmt.Printf("  missing indexes: %v\n", r.gaps)
+	}
+	switch {
+	case r.counter == nil:
+		fmt.Println("  counter: not initialized (starts at the next index on the first insert)")
+	case *r.counter < r.next:
+		fmt.Printf("  counter: %d is behind the next index %d\n", *r.counter, r.next)
+	default:
+		fmt.Printf("  counter: %d\n", *r.counter)
+	}
+	if r.ok() {
+		fmt.Println("  OK")
+	}
+}
+
+// check inspects indexes, which must be sorted in ascending order
+func check(ctx context.Context, client *ent.Client, name string, total int, indexes []int) (indexReport, error) {
+	report := indexReport{name: name, total: total, missing: total - len(indexes)}
+
+	expected := 0
+	for i, index := range indexes {
+		if i > 0 && index == indexes[i-1] {
+			if len(report.duplicates) == 0 || report.duplicates[len(report.duplicates)-1] != index {
+				report.duplicates = append(report.duplicates, index)
+			}
+			continue
+		}
+		for ; expected < index; expected++ {
+			report.gaps = append(report.gaps, expected)
+		}
+		expected = index + 1
+	}
+
+	next, err := infra.NextUnusedIndex(ctx, client, name)
+	if err != nil {
+		return report, err
+	}
+	report.next = next
+
+	counter, err := client.IndexCounter.Query().
+		Where(indexcounter.Name(name)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return report, err
+	}
+	if counter != nil {
+		report.counter = &counter.NextValue
+	}
+	return report, nil
+}
+
+func main() {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL environment variable is not set")
+	}
+
+	client, err := ent.Open("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// 論理削除された行も index を保持しているため、すべての行を対象にする
+	userTotal, err := client.User.Query().Count(ctx)
+	if err != nil {
+		log.Fatalf("failed counting users: %v", err)
+	}
+	userIndexes, err := client.User.Query().
+		Where(user.IndexNotNil()).
+		Order(user.ByIndex()).
+		Select(user.FieldIndex).
+		Ints(ctx)
+	if err != nil {
+		log.Fatalf("failed querying user indexes: %v", err)
+	}
+	userReport, err := check(ctx, client, infra.UserIndexCounter, userTotal, userIndexes)
+	if err != nil {
+		log.Fatalf("failed checking user indexes: %v", err)
+	}
+
+	postTotal, err := client.Post.Query().Count(ctx)
+	if err != nil {
+		log.Fatalf("failed counting posts: %v", err)
+	}
+	postIndexes, err := client.Post.Query().
+		Where(post.IndexNotNil()).
+		Order(post.ByIndex()).
+		Select(post.FieldIndex).
+		Ints(ctx)
+	if err != nil {
+		log.Fatalf("failed querying post indexes: %v", err)
+	}
+	postReport, err := check(ctx, client, infra.PostIndexCounter, postTotal, postIndexes)
+	if err != nil {
+		log.Fatalf("failed checking post indexes: %v", err)
+	}
+
+	userReport.print()
+	postReport.print()
+	if !userReport.ok() || !postReport.ok() {
+		os.Exit(1)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
 	"github.com/aki-13627/animalia/backend-go/internal/routes"
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
@@ -36,7 +37,7 @@ func init() {
 	}
 
 	// Auto migration
-	if err := client.Schema.Create(context.Background()); err != nil {
+	if err := infra.MigrateSchema(context.Background(), client); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
@@ -100,6 +101,15 @@ func init() {
 	routes.SetupUserRoutes(app)
 	routes.SetupLikeRoutes(app)
 	routes.SetupCommentRoutes(app)
+	routes.SetupTaskRoutes(app)
+	routes.SetupAdminRoutes(app)
+	routes.SetupSearchRoutes(app)
+	routes.SetupUploadRoutes(app)
+	routes.SetupNotificationRoutes(app)
+	routes.SetupPushRoutes(app)
+	routes.SetupFileRoutes(app)
+	routes.SetupTagRoutes(app)
+	// Streams are not set up here, since API Gateway buffers the whole response of a Lambda function
 	log.Println("API routes setup completed")
 
 	// Initialize the Lambda adapter
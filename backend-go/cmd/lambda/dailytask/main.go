@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
-	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
 	"github.com/aws/aws-lambda-go/lambda"
 	_ "github.com/lib/pq" // PostgreSQLドライバー
 	"github.com/samber/lo"
@@ -30,17 +32,36 @@ func Handler(ctx context.Context) error {
 	}
 	defer client.Close()
 
-	users, err := client.User.Query().All(ctx)
+	taskTypes, err := infra.NewTaskTypeRepository(client).List(false)
+	if err != nil {
+		log.Fatalf("failed querying task types: %v", err)
+		return err
+	}
+	if len(taskTypes) == 0 {
+		return errors.New("no active task types in the catalog")
+	}
+
+	users, err := client.User.Query().
+		WithPets(func(q *ent.PetQuery) {
+			q.Where(pet.DeletedAtIsNil()).Select(pet.FieldType)
+		}).
+		All(ctx)
 	if err != nil {
 		log.Fatalf("failed querying users: %v", err)
 		return err
 	}
-	tasks := lo.Map(users, func(u *ent.User, _ int) *ent.DailyTaskCreate {
-		return client.DailyTask.Create().
-			SetCreatedAt(time.Now().Truncate(24 * time.Hour)).
-			SetType(getRandomTaskType()).
-			SetUserID(u.ID)
-	})
+	tasks := make([]*ent.DailyTaskCreate, 0, len(users))
+	for _, u := range users {
+		taskType := pickTaskType(taskTypes, u.Edges.Pets)
+		if taskType == nil {
+			log.Printf("no task type applies to the pets of user %s", u.ID)
+			continue
+		}
+		tasks = append(tasks, client.DailyTask.Create().
+			SetCreatedAt(models.StartOfTaskDay(time.Now())).
+			SetType(taskType.Type).
+			SetUserID(u.ID))
+	}
 	client.DailyTask.CreateBulk(tasks...).SaveX(ctx)
 
 	// Log the number of tasks created
@@ -48,13 +69,29 @@ func Handler(ctx context.Context) error {
 	return nil
 }
 
-func getRandomTaskType() enum.TaskType {
-	taskTypes := []enum.TaskType{
-		enum.TypeEating,
-		enum.TypeSleeping,
-		enum.TypePlaying,
+// pickTaskType draws a task suited to the user's pets, weighted by the catalog weight
+func pickTaskType(taskTypes []*ent.TaskType, pets []*ent.Pet) *ent.TaskType {
+	petTypes := lo.SliceToMap(pets, func(p *ent.Pet) (string, bool) {
+		return string(p.Type), true
+	})
+	candidates := lo.Filter(taskTypes, func(t *ent.TaskType, _ int) bool {
+		return models.AppliesToPets(t, petTypes)
+	})
+	total := lo.SumBy(candidates, func(t *ent.TaskType) int {
+		return t.Weight
+	})
+	if total == 0 {
+		return nil
 	}
-	return taskTypes[rand.Intn(len(taskTypes))]
+
+	n := rand.Intn(total)
+	for _, t := range candidates {
+		if n < t.Weight {
+			return t
+		}
+		n -= t.Weight
+	}
+	return nil
 }
 
 func main() {
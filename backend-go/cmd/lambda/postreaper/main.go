@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+const (
+	// 論理削除から画像を削除するまでの猶予期間
+	gracePeriod = 7 * 24 * time.Hour
+	batchSize   = 500
+)
+
+func Handler(ctx context.Context) error {
+	postReaper := injector.InjectPostReaper()
+
+	reaped, err := postReaper.ReapDeletedPosts(gracePeriod, batchSize)
+	if err != nil {
+		log.Printf("failed reaping deleted posts: %v", err)
+		return err
+	}
+
+	// Log the number of images deleted
+	log.Printf("Deleted images of %d posts", reaped)
+	return nil
+}
+
+func main() {
+	lambda.Start(Handler)
+}
@@ -0,0 +1,102 @@
+// tagbackfill parses the hashtags of existing captions into tags, for the posts written before
+// hashtags were parsed on create and update. Posts whose tags already match their caption are left as is,
+// so it can be run again safely. With -dry-run it only reports the posts it would change.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq" // PostgreSQLドライバー
+)
+
+// batchSize is the number of posts read at a time
+const batchSize = 500
+
+// sameTags reports whether the post already has exactly the parsed tags
+func sameTags(current []*ent.Tag, parsed []string) bool {
+	if len(current) != len(parsed) {
+		return false
+	}
+	for _, t := range current {
+		if !slices.Contains(parsed, t.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report the posts to update without changing them")
+	flag.Parse()
+
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL environment variable is not set")
+	}
+
+	client, err := ent.Open("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	var (
+		scanned, updated int
+		last             *uuid.UUID
+	)
+	// 論理削除された投稿もタグの表示から外れるだけなので、すべての投稿を対象にする
+	for {
+		query := client.Post.Query().
+			Select(post.FieldID, post.FieldCaption).
+			WithTags(func(q *ent.TagQuery) {
+				q.Select(tag.FieldID, tag.FieldName)
+			}).
+			Order(post.ByID()).
+			Limit(batchSize)
+		if last != nil {
+			query = query.Where(post.IDGT(*last))
+		}
+		posts, err := query.All(ctx)
+		if err != nil {
+			log.Fatalf("failed querying posts: %v", err)
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		for _, p := range posts {
+			scanned++
+			tags := models.ParseHashtags(p.Caption)
+			if sameTags(p.Edges.Tags, tags) {
+				continue
+			}
+			updated++
+			if *dryRun {
+				fmt.Printf("%s: %v\n", p.ID, tags)
+				continue
+			}
+			if err := infra.ReplacePostTags(ctx, client, p.ID, tags); err != nil {
+				log.Fatalf("failed updating tags of post %s: %v", p.ID, err)
+			}
+		}
+		last = &posts[len(posts)-1].ID
+	}
+
+	if *dryRun {
+		fmt.Printf("posts: %d, to update: %d\n", scanned, updated)
+		return
+	}
+	fmt.Printf("posts: %d, updated: %d\n", scanned, updated)
+}
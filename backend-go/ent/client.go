@@ -19,9 +19,14 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/tasktype"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 )
@@ -37,12 +42,22 @@ type Client struct {
 	DailyTask *DailyTaskClient
 	// FollowRelation is the client for interacting with the FollowRelation builders.
 	FollowRelation *FollowRelationClient
+	// IndexCounter is the client for interacting with the IndexCounter builders.
+	IndexCounter *IndexCounterClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
+	// LocalAccount is the client for interacting with the LocalAccount builders.
+	LocalAccount *LocalAccountClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PushToken is the client for interacting with the PushToken builders.
+	PushToken *PushTokenClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// TaskType is the client for interacting with the TaskType builders.
 	TaskType *TaskTypeClient
 	// User is the client for interacting with the User builders.
@@ -61,9 +76,14 @@ func (c *Client) init() {
 	c.Comment = NewCommentClient(c.config)
 	c.DailyTask = NewDailyTaskClient(c.config)
 	c.FollowRelation = NewFollowRelationClient(c.config)
+	c.IndexCounter = NewIndexCounterClient(c.config)
 	c.Like = NewLikeClient(c.config)
+	c.LocalAccount = NewLocalAccountClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
 	c.Pet = NewPetClient(c.config)
 	c.Post = NewPostClient(c.config)
+	c.PushToken = NewPushTokenClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.TaskType = NewTaskTypeClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -161,9 +181,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Comment:        NewCommentClient(cfg),
 		DailyTask:      NewDailyTaskClient(cfg),
 		FollowRelation: NewFollowRelationClient(cfg),
+		IndexCounter:   NewIndexCounterClient(cfg),
 		Like:           NewLikeClient(cfg),
+		LocalAccount:   NewLocalAccountClient(cfg),
+		Notification:   NewNotificationClient(cfg),
 		Pet:            NewPetClient(cfg),
 		Post:           NewPostClient(cfg),
+		PushToken:      NewPushTokenClient(cfg),
+		Tag:            NewTagClient(cfg),
 		TaskType:       NewTaskTypeClient(cfg),
 		User:           NewUserClient(cfg),
 	}, nil
@@ -188,9 +213,14 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Comment:        NewCommentClient(cfg),
 		DailyTask:      NewDailyTaskClient(cfg),
 		FollowRelation: NewFollowRelationClient(cfg),
+		IndexCounter:   NewIndexCounterClient(cfg),
 		Like:           NewLikeClient(cfg),
+		LocalAccount:   NewLocalAccountClient(cfg),
+		Notification:   NewNotificationClient(cfg),
 		Pet:            NewPetClient(cfg),
 		Post:           NewPostClient(cfg),
+		PushToken:      NewPushTokenClient(cfg),
+		Tag:            NewTagClient(cfg),
 		TaskType:       NewTaskTypeClient(cfg),
 		User:           NewUserClient(cfg),
 	}, nil
@@ -222,7 +252,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Comment, c.DailyTask, c.FollowRelation, c.Like, c.Pet, c.Post, c.TaskType,
+		c.Comment, c.DailyTask, c.FollowRelation, c.IndexCounter, c.Like,
+		c.LocalAccount, c.Notification, c.Pet, c.Post, c.PushToken, c.Tag, c.TaskType,
 		c.User,
 	} {
 		n.Use(hooks...)
@@ -233,7 +264,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Comment, c.DailyTask, c.FollowRelation, c.Like, c.Pet, c.Post, c.TaskType,
+		c.Comment, c.DailyTask, c.FollowRelation, c.IndexCounter, c.Like,
+		c.LocalAccount, c.Notification, c.Pet, c.Post, c.PushToken, c.Tag, c.TaskType,
 		c.User,
 	} {
 		n.Intercept(interceptors...)
@@ -249,12 +281,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.DailyTask.mutate(ctx, m)
 	case *FollowRelationMutation:
 		return c.FollowRelation.mutate(ctx, m)
+	case *IndexCounterMutation:
+		return c.IndexCounter.mutate(ctx, m)
 	case *LikeMutation:
 		return c.Like.mutate(ctx, m)
+	case *LocalAccountMutation:
+		return c.LocalAccount.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
 	case *PetMutation:
 		return c.Pet.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
+	case *PushTokenMutation:
+		return c.PushToken.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *TaskTypeMutation:
 		return c.TaskType.mutate(ctx, m)
 	case *UserMutation:
@@ -404,6 +446,38 @@ func (c *CommentClient) QueryUser(co *Comment) *UserQuery {
 	return query
 }
 
+// QueryParent queries the parent edge of a Comment.
+func (c *CommentClient) QueryParent(co *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ParentTable, comment.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a Comment.
+func (c *CommentClient) QueryReplies(co *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.RepliesTable, comment.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
@@ -759,6 +833,139 @@ func (c *FollowRelationClient) mutate(ctx context.Context, m *FollowRelationMuta
 	}
 }
 
+// IndexCounterClient is a client for the IndexCounter schema.
+type IndexCounterClient struct {
+	config
+}
+
+// NewIndexCounterClient returns a client for the IndexCounter from the given config.
+func NewIndexCounterClient(c config) *IndexCounterClient {
+	return &IndexCounterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `indexcounter.Hooks(f(g(h())))`.
+func (c *IndexCounterClient) Use(hooks ...Hook) {
+	c.hooks.IndexCounter = append(c.hooks.IndexCounter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `indexcounter.Intercept(f(g(h())))`.
+func (c *IndexCounterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IndexCounter = append(c.inters.IndexCounter, interceptors...)
+}
+
+// Create returns a builder for creating a IndexCounter entity.
+func (c *IndexCounterClient) Create() *IndexCounterCreate {
+	mutation := newIndexCounterMutation(c.config, OpCreate)
+	return &IndexCounterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IndexCounter entities.
+func (c *IndexCounterClient) CreateBulk(builders ...*IndexCounterCreate) *IndexCounterCreateBulk {
+	return &IndexCounterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IndexCounterClient) MapCreateBulk(slice any, setFunc func(*IndexCounterCreate, int)) *IndexCounterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IndexCounterCreateBulk{err: fmt.Errorf("calling to IndexCounterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IndexCounterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IndexCounterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IndexCounter.
+func (c *IndexCounterClient) Update() *IndexCounterUpdate {
+	mutation := newIndexCounterMutation(c.config, OpUpdate)
+	return &IndexCounterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IndexCounterClient) UpdateOne(ic *IndexCounter) *IndexCounterUpdateOne {
+	mutation := newIndexCounterMutation(c.config, OpUpdateOne, withIndexCounter(ic))
+	return &IndexCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IndexCounterClient) UpdateOneID(id int) *IndexCounterUpdateOne {
+	mutation := newIndexCounterMutation(c.config, OpUpdateOne, withIndexCounterID(id))
+	return &IndexCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IndexCounter.
+func (c *IndexCounterClient) Delete() *IndexCounterDelete {
+	mutation := newIndexCounterMutation(c.config, OpDelete)
+	return &IndexCounterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IndexCounterClient) DeleteOne(ic *IndexCounter) *IndexCounterDeleteOne {
+	return c.DeleteOneID(ic.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IndexCounterClient) DeleteOneID(id int) *IndexCounterDeleteOne {
+	builder := c.Delete().Where(indexcounter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IndexCounterDeleteOne{builder}
+}
+
+// Query returns a query builder for IndexCounter.
+func (c *IndexCounterClient) Query() *IndexCounterQuery {
+	return &IndexCounterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIndexCounter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IndexCounter entity by its id.
+func (c *IndexCounterClient) Get(ctx context.Context, id int) (*IndexCounter, error) {
+	return c.Query().Where(indexcounter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IndexCounterClient) GetX(ctx context.Context, id int) *IndexCounter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IndexCounterClient) Hooks() []Hook {
+	return c.hooks.IndexCounter
+}
+
+// Interceptors returns the client interceptors.
+func (c *IndexCounterClient) Interceptors() []Interceptor {
+	return c.inters.IndexCounter
+}
+
+func (c *IndexCounterClient) mutate(ctx context.Context, m *IndexCounterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IndexCounterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IndexCounterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IndexCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IndexCounterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IndexCounter mutation op: %q", m.Op())
+	}
+}
+
 // LikeClient is a client for the Like schema.
 type LikeClient struct {
 	config
@@ -924,6 +1131,320 @@ func (c *LikeClient) mutate(ctx context.Context, m *LikeMutation) (Value, error)
 	}
 }
 
+// LocalAccountClient is a client for the LocalAccount schema.
+type LocalAccountClient struct {
+	config
+}
+
+// NewLocalAccountClient returns a client for the LocalAccount from the given config.
+func NewLocalAccountClient(c config) *LocalAccountClient {
+	return &LocalAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `localaccount.Hooks(f(g(h())))`.
+func (c *LocalAccountClient) Use(hooks ...Hook) {
+	c.hooks.LocalAccount = append(c.hooks.LocalAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `localaccount.Intercept(f(g(h())))`.
+func (c *LocalAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LocalAccount = append(c.inters.LocalAccount, interceptors...)
+}
+
+// Create returns a builder for creating a LocalAccount entity.
+func (c *LocalAccountClient) Create() *LocalAccountCreate {
+	mutation := newLocalAccountMutation(c.config, OpCreate)
+	return &LocalAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LocalAccount entities.
+func (c *LocalAccountClient) CreateBulk(builders ...*LocalAccountCreate) *LocalAccountCreateBulk {
+	return &LocalAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocalAccountClient) MapCreateBulk(slice any, setFunc func(*LocalAccountCreate, int)) *LocalAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocalAccountCreateBulk{err: fmt.Errorf("calling to LocalAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocalAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocalAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LocalAccount.
+func (c *LocalAccountClient) Update() *LocalAccountUpdate {
+	mutation := newLocalAccountMutation(c.config, OpUpdate)
+	return &LocalAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocalAccountClient) UpdateOne(la *LocalAccount) *LocalAccountUpdateOne {
+	mutation := newLocalAccountMutation(c.config, OpUpdateOne, withLocalAccount(la))
+	return &LocalAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocalAccountClient) UpdateOneID(id uuid.UUID) *LocalAccountUpdateOne {
+	mutation := newLocalAccountMutation(c.config, OpUpdateOne, withLocalAccountID(id))
+	return &LocalAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LocalAccount.
+func (c *LocalAccountClient) Delete() *LocalAccountDelete {
+	mutation := newLocalAccountMutation(c.config, OpDelete)
+	return &LocalAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocalAccountClient) DeleteOne(la *LocalAccount) *LocalAccountDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocalAccountClient) DeleteOneID(id uuid.UUID) *LocalAccountDeleteOne {
+	builder := c.Delete().Where(localaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocalAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for LocalAccount.
+func (c *LocalAccountClient) Query() *LocalAccountQuery {
+	return &LocalAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocalAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LocalAccount entity by its id.
+func (c *LocalAccountClient) Get(ctx context.Context, id uuid.UUID) (*LocalAccount, error) {
+	return c.Query().Where(localaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocalAccountClient) GetX(ctx context.Context, id uuid.UUID) *LocalAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LocalAccountClient) Hooks() []Hook {
+	return c.hooks.LocalAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocalAccountClient) Interceptors() []Interceptor {
+	return c.inters.LocalAccount
+}
+
+func (c *LocalAccountClient) mutate(ctx context.Context, m *LocalAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocalAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocalAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocalAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocalAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LocalAccount mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRecipient queries the recipient edge of a Notification.
+func (c *NotificationClient) QueryRecipient(n *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.RecipientTable, notification.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActor queries the actor edge of a Notification.
+func (c *NotificationClient) QueryActor(n *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.ActorTable, notification.ActorColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPost queries the post edge of a Notification.
+func (c *NotificationClient) QueryPost(n *Notification) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.PostTable, notification.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
 // PetClient is a client for the Pet schema.
 type PetClient struct {
 	config
@@ -1245,6 +1766,22 @@ func (c *PostClient) QueryDailyTask(po *Post) *DailyTaskQuery {
 	return query
 }
 
+// QueryTags queries the tags edge of a Post.
+func (c *PostClient) QueryTags(po *Post) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, post.TagsTable, post.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PostClient) Hooks() []Hook {
 	return c.hooks.Post
@@ -1270,6 +1807,304 @@ func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error)
 	}
 }
 
+// PushTokenClient is a client for the PushToken schema.
+type PushTokenClient struct {
+	config
+}
+
+// NewPushTokenClient returns a client for the PushToken from the given config.
+func NewPushTokenClient(c config) *PushTokenClient {
+	return &PushTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pushtoken.Hooks(f(g(h())))`.
+func (c *PushTokenClient) Use(hooks ...Hook) {
+	c.hooks.PushToken = append(c.hooks.PushToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pushtoken.Intercept(f(g(h())))`.
+func (c *PushTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PushToken = append(c.inters.PushToken, interceptors...)
+}
+
+// Create returns a builder for creating a PushToken entity.
+func (c *PushTokenClient) Create() *PushTokenCreate {
+	mutation := newPushTokenMutation(c.config, OpCreate)
+	return &PushTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PushToken entities.
+func (c *PushTokenClient) CreateBulk(builders ...*PushTokenCreate) *PushTokenCreateBulk {
+	return &PushTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PushTokenClient) MapCreateBulk(slice any, setFunc func(*PushTokenCreate, int)) *PushTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PushTokenCreateBulk{err: fmt.Errorf("calling to PushTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PushTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PushTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PushToken.
+func (c *PushTokenClient) Update() *PushTokenUpdate {
+	mutation := newPushTokenMutation(c.config, OpUpdate)
+	return &PushTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PushTokenClient) UpdateOne(pt *PushToken) *PushTokenUpdateOne {
+	mutation := newPushTokenMutation(c.config, OpUpdateOne, withPushToken(pt))
+	return &PushTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PushTokenClient) UpdateOneID(id uuid.UUID) *PushTokenUpdateOne {
+	mutation := newPushTokenMutation(c.config, OpUpdateOne, withPushTokenID(id))
+	return &PushTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PushToken.
+func (c *PushTokenClient) Delete() *PushTokenDelete {
+	mutation := newPushTokenMutation(c.config, OpDelete)
+	return &PushTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PushTokenClient) DeleteOne(pt *PushToken) *PushTokenDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PushTokenClient) DeleteOneID(id uuid.UUID) *PushTokenDeleteOne {
+	builder := c.Delete().Where(pushtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PushToken.
+func (c *PushTokenClient) Query() *PushTokenQuery {
+	return &PushTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePushToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PushToken entity by its id.
+func (c *PushTokenClient) Get(ctx context.Context, id uuid.UUID) (*PushToken, error) {
+	return c.Query().Where(pushtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushTokenClient) GetX(ctx context.Context, id uuid.UUID) *PushToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PushToken.
+func (c *PushTokenClient) QueryUser(pt *PushToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pushtoken.Table, pushtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pushtoken.UserTable, pushtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PushTokenClient) Hooks() []Hook {
+	return c.hooks.PushToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *PushTokenClient) Interceptors() []Interceptor {
+	return c.inters.PushToken
+}
+
+func (c *PushTokenClient) mutate(ctx context.Context, m *PushTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PushTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PushTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PushTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PushTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PushToken mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id uuid.UUID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id uuid.UUID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uuid.UUID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id uuid.UUID) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a Tag.
+func (c *TagClient) QueryPosts(t *Tag) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.PostsTable, tag.PostsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
+}
+
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
 // TaskTypeClient is a client for the TaskType schema.
 type TaskTypeClient struct {
 	config
@@ -1623,6 +2458,38 @@ func (c *UserClient) QueryDailyTasks(u *User) *DailyTaskQuery {
 	return query
 }
 
+// QueryNotifications queries the notifications edge of a User.
+func (c *UserClient) QueryNotifications(u *User) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPushTokens queries the push_tokens edge of a User.
+func (c *UserClient) QueryPushTokens(u *User) *PushTokenQuery {
+	query := (&PushTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(pushtoken.Table, pushtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PushTokensTable, user.PushTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1651,10 +2518,11 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Comment, DailyTask, FollowRelation, Like, Pet, Post, TaskType, User []ent.Hook
+		Comment, DailyTask, FollowRelation, IndexCounter, Like, LocalAccount,
+		Notification, Pet, Post, PushToken, Tag, TaskType, User []ent.Hook
 	}
 	inters struct {
-		Comment, DailyTask, FollowRelation, Like, Pet, Post, TaskType,
-		User []ent.Interceptor
+		Comment, DailyTask, FollowRelation, IndexCounter, Like, LocalAccount,
+		Notification, Pet, Post, PushToken, Tag, TaskType, User []ent.Interceptor
 	}
 )
@@ -15,9 +15,14 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/tasktype"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 )
@@ -83,9 +88,14 @@ func checkColumn(table, column string) error {
 			comment.Table:        comment.ValidColumn,
 			dailytask.Table:      dailytask.ValidColumn,
 			followrelation.Table: followrelation.ValidColumn,
+			indexcounter.Table:   indexcounter.ValidColumn,
 			like.Table:           like.ValidColumn,
+			localaccount.Table:   localaccount.ValidColumn,
+			notification.Table:   notification.ValidColumn,
 			pet.Table:            pet.ValidColumn,
 			post.Table:           post.ValidColumn,
+			pushtoken.Table:      pushtoken.ValidColumn,
+			tag.Table:            tag.ValidColumn,
 			tasktype.Table:       tasktype.ValidColumn,
 			user.Table:           user.ValidColumn,
 		})
@@ -1,5 +1,7 @@
 package enum
 
+// TaskType is the key of a task in the task_types catalog.
+// The constants below are the built-in tasks; admins can add more to the catalog.
 type TaskType string
 
 const (
@@ -27,6 +27,7 @@ type FollowRelationQuery struct {
 	withFrom   *UserQuery
 	withTo     *UserQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (frq *FollowRelationQuery) Clone() *FollowRelationQuery {
 		withFrom:   frq.withFrom.Clone(),
 		withTo:     frq.withTo.Clone(),
 		// clone intermediate query.
-		sql:  frq.sql.Clone(),
-		path: frq.path,
+		sql:       frq.sql.Clone(),
+		path:      frq.path,
+		modifiers: append([]func(*sql.Selector){}, frq.modifiers...),
 	}
 }
 
@@ -428,6 +430,9 @@ func (frq *FollowRelationQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(frq.modifiers) > 0 {
+		_spec.Modifiers = frq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -519,6 +524,9 @@ func (frq *FollowRelationQuery) loadTo(ctx context.Context, query *UserQuery, no
 
 func (frq *FollowRelationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := frq.querySpec()
+	if len(frq.modifiers) > 0 {
+		_spec.Modifiers = frq.modifiers
+	}
 	_spec.Node.Columns = frq.ctx.Fields
 	if len(frq.ctx.Fields) > 0 {
 		_spec.Unique = frq.ctx.Unique != nil && *frq.ctx.Unique
@@ -581,6 +589,9 @@ func (frq *FollowRelationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if frq.ctx.Unique != nil && *frq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range frq.modifiers {
+		m(selector)
+	}
 	for _, p := range frq.predicates {
 		p(selector)
 	}
@@ -598,6 +609,12 @@ func (frq *FollowRelationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (frq *FollowRelationQuery) Modify(modifiers ...func(s *sql.Selector)) *FollowRelationSelect {
+	frq.modifiers = append(frq.modifiers, modifiers...)
+	return frq.Select()
+}
+
 // FollowRelationGroupBy is the group-by builder for FollowRelation entities.
 type FollowRelationGroupBy struct {
 	selector
@@ -687,3 +704,9 @@ func (frs *FollowRelationSelect) sqlScan(ctx context.Context, root *FollowRelati
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (frs *FollowRelationSelect) Modify(modifiers ...func(s *sql.Selector)) *FollowRelationSelect {
+	frs.modifiers = append(frs.modifiers, modifiers...)
+	return frs
+}
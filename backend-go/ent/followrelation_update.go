@@ -20,8 +20,9 @@ import (
 // FollowRelationUpdate is the builder for updating FollowRelation entities.
 type FollowRelationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FollowRelationMutation
+	hooks     []Hook
+	mutation  *FollowRelationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FollowRelationUpdate builder.
@@ -121,6 +122,12 @@ func (fru *FollowRelationUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fru *FollowRelationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowRelationUpdate {
+	fru.modifiers = append(fru.modifiers, modifiers...)
+	return fru
+}
+
 func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := fru.check(); err != nil {
 		return n, err
@@ -194,6 +201,7 @@ func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{followrelation.Label}
@@ -209,9 +217,10 @@ func (fru *FollowRelationUpdate) sqlSave(ctx context.Context) (n int, err error)
 // FollowRelationUpdateOne is the builder for updating a single FollowRelation entity.
 type FollowRelationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FollowRelationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FollowRelationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -318,6 +327,12 @@ func (fruo *FollowRelationUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fruo *FollowRelationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowRelationUpdateOne {
+	fruo.modifiers = append(fruo.modifiers, modifiers...)
+	return fruo
+}
+
 func (fruo *FollowRelationUpdateOne) sqlSave(ctx context.Context) (_node *FollowRelation, err error) {
 	if err := fruo.check(); err != nil {
 		return _node, err
@@ -408,6 +423,7 @@ func (fruo *FollowRelationUpdateOne) sqlSave(ctx context.Context) (_node *Follow
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fruo.modifiers...)
 	_node = &FollowRelation{config: fruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
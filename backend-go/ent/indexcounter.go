@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
+)
+
+// IndexCounter is the model entity for the IndexCounter schema.
+type IndexCounter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// NextValue holds the value of the "next_value" field.
+	NextValue    int `json:"next_value,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IndexCounter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case indexcounter.FieldID, indexcounter.FieldNextValue:
+			values[i] = new(sql.NullInt64)
+		case indexcounter.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IndexCounter fields.
+func (ic *IndexCounter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case indexcounter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ic.ID = int(value.Int64)
+		case indexcounter.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ic.Name = value.String
+			}
+		case indexcounter.FieldNextValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_value", values[i])
+			} else if value.Valid {
+				ic.NextValue = int(value.Int64)
+			}
+		default:
+			ic.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IndexCounter.
+// This includes values selected through modifiers, order, etc.
+func (ic *IndexCounter) Value(name string) (ent.Value, error) {
+	return ic.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IndexCounter.
+// Note that you need to call IndexCounter.Unwrap() before calling this method if this IndexCounter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ic *IndexCounter) Update() *IndexCounterUpdateOne {
+	return NewIndexCounterClient(ic.config).UpdateOne(ic)
+}
+
+// Unwrap unwraps the IndexCounter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ic *IndexCounter) Unwrap() *IndexCounter {
+	_tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IndexCounter is not a transactional entity")
+	}
+	ic.config.driver = _tx.drv
+	return ic
+}
+
+// String implements the fmt.Stringer.
+func (ic *IndexCounter) String() string {
+	var builder strings.Builder
+	builder.WriteString("IndexCounter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ic.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ic.Name)
+	builder.WriteString(", ")
+	builder.WriteString("next_value=")
+	builder.WriteString(fmt.Sprintf("%v", ic.NextValue))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IndexCounters is a parsable slice of IndexCounter.
+type IndexCounters []*IndexCounter
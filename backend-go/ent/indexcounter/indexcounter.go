@@ -0,0 +1,62 @@
+// Code generated by ent, DO NOT EDIT.
+
+package indexcounter
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the indexcounter type in the database.
+	Label = "index_counter"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldNextValue holds the string denoting the next_value field in the database.
+	FieldNextValue = "next_value"
+	// Table holds the table name of the indexcounter in the database.
+	Table = "index_counters"
+)
+
+// Columns holds all SQL columns for indexcounter fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldNextValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// NextValueValidator is a validator for the "next_value" field. It is called by the builders before save.
+	NextValueValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the IndexCounter queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByNextValue orders the results by the next_value field.
+func ByNextValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextValue, opts...).ToFunc()
+}
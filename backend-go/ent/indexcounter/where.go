@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package indexcounter
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEQ(FieldName, v))
+}
+
+// NextValue applies equality check predicate on the "next_value" field. It's identical to NextValueEQ.
+func NextValue(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEQ(FieldNextValue, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldContainsFold(FieldName, v))
+}
+
+// NextValueEQ applies the EQ predicate on the "next_value" field.
+func NextValueEQ(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldEQ(FieldNextValue, v))
+}
+
+// NextValueNEQ applies the NEQ predicate on the "next_value" field.
+func NextValueNEQ(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldNEQ(FieldNextValue, v))
+}
+
+// NextValueIn applies the In predicate on the "next_value" field.
+func NextValueIn(vs ...int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldIn(FieldNextValue, vs...))
+}
+
+// NextValueNotIn applies the NotIn predicate on the "next_value" field.
+func NextValueNotIn(vs ...int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldNotIn(FieldNextValue, vs...))
+}
+
+// NextValueGT applies the GT predicate on the "next_value" field.
+func NextValueGT(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldGT(FieldNextValue, v))
+}
+
+// NextValueGTE applies the GTE predicate on the "next_value" field.
+func NextValueGTE(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldGTE(FieldNextValue, v))
+}
+
+// NextValueLT applies the LT predicate on the "next_value" field.
+func NextValueLT(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldLT(FieldNextValue, v))
+}
+
+// NextValueLTE applies the LTE predicate on the "next_value" field.
+func NextValueLTE(v int) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.FieldLTE(FieldNextValue, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IndexCounter) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IndexCounter) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IndexCounter) predicate.IndexCounter {
+	return predicate.IndexCounter(sql.NotPredicates(p))
+}
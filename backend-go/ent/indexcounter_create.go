@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
+)
+
+// IndexCounterCreate is the builder for creating a IndexCounter entity.
+type IndexCounterCreate struct {
+	config
+	mutation *IndexCounterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (icc *IndexCounterCreate) SetName(s string) *IndexCounterCreate {
+	icc.mutation.SetName(s)
+	return icc
+}
+
+// SetNextValue sets the "next_value" field.
+func (icc *IndexCounterCreate) SetNextValue(i int) *IndexCounterCreate {
+	icc.mutation.SetNextValue(i)
+	return icc
+}
+
+// Mutation returns the IndexCounterMutation object of the builder.
+func (icc *IndexCounterCreate) Mutation() *IndexCounterMutation {
+	return icc.mutation
+}
+
+// Save creates the IndexCounter in the database.
+func (icc *IndexCounterCreate) Save(ctx context.Context) (*IndexCounter, error) {
+	return withHooks(ctx, icc.sqlSave, icc.mutation, icc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icc *IndexCounterCreate) SaveX(ctx context.Context) *IndexCounter {
+	v, err := icc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icc *IndexCounterCreate) Exec(ctx context.Context) error {
+	_, err := icc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icc *IndexCounterCreate) ExecX(ctx context.Context) {
+	if err := icc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icc *IndexCounterCreate) check() error {
+	if _, ok := icc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "IndexCounter.name"`)}
+	}
+	if v, ok := icc.mutation.Name(); ok {
+		if err := indexcounter.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "IndexCounter.name": %w`, err)}
+		}
+	}
+	if _, ok := icc.mutation.NextValue(); !ok {
+		return &ValidationError{Name: "next_value", err: errors.New(`ent: missing required field "IndexCounter.next_value"`)}
+	}
+	if v, ok := icc.mutation.NextValue(); ok {
+		if err := indexcounter.NextValueValidator(v); err != nil {
+			return &ValidationError{Name: "next_value", err: fmt.Errorf(`ent: validator failed for field "IndexCounter.next_value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (icc *IndexCounterCreate) sqlSave(ctx context.Context) (*IndexCounter, error) {
+	if err := icc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := icc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	icc.mutation.id = &_node.ID
+	icc.mutation.done = true
+	return _node, nil
+}
+
+func (icc *IndexCounterCreate) createSpec() (*IndexCounter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IndexCounter{config: icc.config}
+		_spec = sqlgraph.NewCreateSpec(indexcounter.Table, sqlgraph.NewFieldSpec(indexcounter.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = icc.conflict
+	if value, ok := icc.mutation.Name(); ok {
+		_spec.SetField(indexcounter.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := icc.mutation.NextValue(); ok {
+		_spec.SetField(indexcounter.FieldNextValue, field.TypeInt, value)
+		_node.NextValue = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IndexCounter.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IndexCounterUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (icc *IndexCounterCreate) OnConflict(opts ...sql.ConflictOption) *IndexCounterUpsertOne {
+	icc.conflict = opts
+	return &IndexCounterUpsertOne{
+		create: icc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IndexCounter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icc *IndexCounterCreate) OnConflictColumns(columns ...string) *IndexCounterUpsertOne {
+	icc.conflict = append(icc.conflict, sql.ConflictColumns(columns...))
+	return &IndexCounterUpsertOne{
+		create: icc,
+	}
+}
+
+type (
+	// IndexCounterUpsertOne is the builder for "upsert"-ing
+	//  one IndexCounter node.
+	IndexCounterUpsertOne struct {
+		create *IndexCounterCreate
+	}
+
+	// IndexCounterUpsert is the "OnConflict" setter.
+	IndexCounterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *IndexCounterUpsert) SetName(v string) *IndexCounterUpsert {
+	u.Set(indexcounter.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *IndexCounterUpsert) UpdateName() *IndexCounterUpsert {
+	u.SetExcluded(indexcounter.FieldName)
+	return u
+}
+
+// SetNextValue sets the "next_value" field.
+func (u *IndexCounterUpsert) SetNextValue(v int) *IndexCounterUpsert {
+	u.Set(indexcounter.FieldNextValue, v)
+	return u
+}
+
+// UpdateNextValue sets the "next_value" field to the value that was provided on create.
+func (u *IndexCounterUpsert) UpdateNextValue() *IndexCounterUpsert {
+	u.SetExcluded(indexcounter.FieldNextValue)
+	return u
+}
+
+// AddNextValue adds v to the "next_value" field.
+func (u *IndexCounterUpsert) AddNextValue(v int) *IndexCounterUpsert {
+	u.Add(indexcounter.FieldNextValue, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.IndexCounter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *IndexCounterUpsertOne) UpdateNewValues() *IndexCounterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IndexCounter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IndexCounterUpsertOne) Ignore() *IndexCounterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IndexCounterUpsertOne) DoNothing() *IndexCounterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IndexCounterCreate.OnConflict
+// documentation for more info.
+func (u *IndexCounterUpsertOne) Update(set func(*IndexCounterUpsert)) *IndexCounterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IndexCounterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *IndexCounterUpsertOne) SetName(v string) *IndexCounterUpsertOne {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *IndexCounterUpsertOne) UpdateName() *IndexCounterUpsertOne {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNextValue sets the "next_value" field.
+func (u *IndexCounterUpsertOne) SetNextValue(v int) *IndexCounterUpsertOne {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.SetNextValue(v)
+	})
+}
+
+// AddNextValue adds v to the "next_value" field.
+func (u *IndexCounterUpsertOne) AddNextValue(v int) *IndexCounterUpsertOne {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.AddNextValue(v)
+	})
+}
+
+// UpdateNextValue sets the "next_value" field to the value that was provided on create.
+func (u *IndexCounterUpsertOne) UpdateNextValue() *IndexCounterUpsertOne {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.UpdateNextValue()
+	})
+}
+
+// Exec executes the query.
+func (u *IndexCounterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IndexCounterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IndexCounterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IndexCounterUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IndexCounterUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IndexCounterCreateBulk is the builder for creating many IndexCounter entities in bulk.
+type IndexCounterCreateBulk struct {
+	config
+	err      error
+	builders []*IndexCounterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IndexCounter entities in the database.
+func (iccb *IndexCounterCreateBulk) Save(ctx context.Context) ([]*IndexCounter, error) {
+	if iccb.err != nil {
+		return nil, iccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iccb.builders))
+	nodes := make([]*IndexCounter, len(iccb.builders))
+	mutators := make([]Mutator, len(iccb.builders))
+	for i := range iccb.builders {
+		func(i int, root context.Context) {
+			builder := iccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IndexCounterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iccb *IndexCounterCreateBulk) SaveX(ctx context.Context) []*IndexCounter {
+	v, err := iccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iccb *IndexCounterCreateBulk) Exec(ctx context.Context) error {
+	_, err := iccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iccb *IndexCounterCreateBulk) ExecX(ctx context.Context) {
+	if err := iccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IndexCounter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IndexCounterUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (iccb *IndexCounterCreateBulk) OnConflict(opts ...sql.ConflictOption) *IndexCounterUpsertBulk {
+	iccb.conflict = opts
+	return &IndexCounterUpsertBulk{
+		create: iccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IndexCounter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iccb *IndexCounterCreateBulk) OnConflictColumns(columns ...string) *IndexCounterUpsertBulk {
+	iccb.conflict = append(iccb.conflict, sql.ConflictColumns(columns...))
+	return &IndexCounterUpsertBulk{
+		create: iccb,
+	}
+}
+
+// IndexCounterUpsertBulk is the builder for "upsert"-ing
+// a bulk of IndexCounter nodes.
+type IndexCounterUpsertBulk struct {
+	create *IndexCounterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IndexCounter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *IndexCounterUpsertBulk) UpdateNewValues() *IndexCounterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IndexCounter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IndexCounterUpsertBulk) Ignore() *IndexCounterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IndexCounterUpsertBulk) DoNothing() *IndexCounterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IndexCounterCreateBulk.OnConflict
+// documentation for more info.
+func (u *IndexCounterUpsertBulk) Update(set func(*IndexCounterUpsert)) *IndexCounterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IndexCounterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *IndexCounterUpsertBulk) SetName(v string) *IndexCounterUpsertBulk {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *IndexCounterUpsertBulk) UpdateName() *IndexCounterUpsertBulk {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNextValue sets the "next_value" field.
+func (u *IndexCounterUpsertBulk) SetNextValue(v int) *IndexCounterUpsertBulk {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.SetNextValue(v)
+	})
+}
+
+// AddNextValue adds v to the "next_value" field.
+func (u *IndexCounterUpsertBulk) AddNextValue(v int) *IndexCounterUpsertBulk {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.AddNextValue(v)
+	})
+}
+
+// UpdateNextValue sets the "next_value" field to the value that was provided on create.
+func (u *IndexCounterUpsertBulk) UpdateNextValue() *IndexCounterUpsertBulk {
+	return u.Update(func(s *IndexCounterUpsert) {
+		s.UpdateNextValue()
+	})
+}
+
+// Exec executes the query.
+func (u *IndexCounterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IndexCounterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IndexCounterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IndexCounterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// IndexCounterDelete is the builder for deleting a IndexCounter entity.
+type IndexCounterDelete struct {
+	config
+	hooks    []Hook
+	mutation *IndexCounterMutation
+}
+
+// Where appends a list predicates to the IndexCounterDelete builder.
+func (icd *IndexCounterDelete) Where(ps ...predicate.IndexCounter) *IndexCounterDelete {
+	icd.mutation.Where(ps...)
+	return icd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icd *IndexCounterDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, icd.sqlExec, icd.mutation, icd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icd *IndexCounterDelete) ExecX(ctx context.Context) int {
+	n, err := icd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icd *IndexCounterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(indexcounter.Table, sqlgraph.NewFieldSpec(indexcounter.FieldID, field.TypeInt))
+	if ps := icd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	icd.mutation.done = true
+	return affected, err
+}
+
+// IndexCounterDeleteOne is the builder for deleting a single IndexCounter entity.
+type IndexCounterDeleteOne struct {
+	icd *IndexCounterDelete
+}
+
+// Where appends a list predicates to the IndexCounterDelete builder.
+func (icdo *IndexCounterDeleteOne) Where(ps ...predicate.IndexCounter) *IndexCounterDeleteOne {
+	icdo.icd.mutation.Where(ps...)
+	return icdo
+}
+
+// Exec executes the deletion query.
+func (icdo *IndexCounterDeleteOne) Exec(ctx context.Context) error {
+	n, err := icdo.icd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{indexcounter.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icdo *IndexCounterDeleteOne) ExecX(ctx context.Context) {
+	if err := icdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// IndexCounterUpdate is the builder for updating IndexCounter entities.
+type IndexCounterUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IndexCounterMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IndexCounterUpdate builder.
+func (icu *IndexCounterUpdate) Where(ps ...predicate.IndexCounter) *IndexCounterUpdate {
+	icu.mutation.Where(ps...)
+	return icu
+}
+
+// SetName sets the "name" field.
+func (icu *IndexCounterUpdate) SetName(s string) *IndexCounterUpdate {
+	icu.mutation.SetName(s)
+	return icu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (icu *IndexCounterUpdate) SetNillableName(s *string) *IndexCounterUpdate {
+	if s != nil {
+		icu.SetName(*s)
+	}
+	return icu
+}
+
+// SetNextValue sets the "next_value" field.
+func (icu *IndexCounterUpdate) SetNextValue(i int) *IndexCounterUpdate {
+	icu.mutation.ResetNextValue()
+	icu.mutation.SetNextValue(i)
+	return icu
+}
+
+// SetNillableNextValue sets the "next_value" field if the given value is not nil.
+func (icu *IndexCounterUpdate) SetNillableNextValue(i *int) *IndexCounterUpdate {
+	if i != nil {
+		icu.SetNextValue(*i)
+	}
+	return icu
+}
+
+// AddNextValue adds i to the "next_value" field.
+func (icu *IndexCounterUpdate) AddNextValue(i int) *IndexCounterUpdate {
+	icu.mutation.AddNextValue(i)
+	return icu
+}
+
+// Mutation returns the IndexCounterMutation object of the builder.
+func (icu *IndexCounterUpdate) Mutation() *IndexCounterMutation {
+	return icu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icu *IndexCounterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, icu.sqlSave, icu.mutation, icu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icu *IndexCounterUpdate) SaveX(ctx context.Context) int {
+	affected, err := icu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icu *IndexCounterUpdate) Exec(ctx context.Context) error {
+	_, err := icu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icu *IndexCounterUpdate) ExecX(ctx context.Context) {
+	if err := icu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icu *IndexCounterUpdate) check() error {
+	if v, ok := icu.mutation.Name(); ok {
+		if err := indexcounter.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "IndexCounter.name": %w`, err)}
+		}
+	}
+	if v, ok := icu.mutation.NextValue(); ok {
+		if err := indexcounter.NextValueValidator(v); err != nil {
+			return &ValidationError{Name: "next_value", err: fmt.Errorf(`ent: validator failed for field "IndexCounter.next_value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (icu *IndexCounterUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IndexCounterUpdate {
+	icu.modifiers = append(icu.modifiers, modifiers...)
+	return icu
+}
+
+func (icu *IndexCounterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := icu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(indexcounter.Table, indexcounter.Columns, sqlgraph.NewFieldSpec(indexcounter.FieldID, field.TypeInt))
+	if ps := icu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icu.mutation.Name(); ok {
+		_spec.SetField(indexcounter.FieldName, field.TypeString, value)
+	}
+	if value, ok := icu.mutation.NextValue(); ok {
+		_spec.SetField(indexcounter.FieldNextValue, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedNextValue(); ok {
+		_spec.AddField(indexcounter.FieldNextValue, field.TypeInt, value)
+	}
+	_spec.AddModifiers(icu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, icu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{indexcounter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	icu.mutation.done = true
+	return n, nil
+}
+
+// IndexCounterUpdateOne is the builder for updating a single IndexCounter entity.
+type IndexCounterUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IndexCounterMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (icuo *IndexCounterUpdateOne) SetName(s string) *IndexCounterUpdateOne {
+	icuo.mutation.SetName(s)
+	return icuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (icuo *IndexCounterUpdateOne) SetNillableName(s *string) *IndexCounterUpdateOne {
+	if s != nil {
+		icuo.SetName(*s)
+	}
+	return icuo
+}
+
+// SetNextValue sets the "next_value" field.
+func (icuo *IndexCounterUpdateOne) SetNextValue(i int) *IndexCounterUpdateOne {
+	icuo.mutation.ResetNextValue()
+	icuo.mutation.SetNextValue(i)
+	return icuo
+}
+
+// SetNillableNextValue sets the "next_value" field if the given value is not nil.
+func (icuo *IndexCounterUpdateOne) SetNillableNextValue(i *int) *IndexCounterUpdateOne {
+	if i != nil {
+		icuo.SetNextValue(*i)
+	}
+	return icuo
+}
+
+// AddNextValue adds i to the "next_value" field.
+func (icuo *IndexCounterUpdateOne) AddNextValue(i int) *IndexCounterUpdateOne {
+	icuo.mutation.AddNextValue(i)
+	return icuo
+}
+
+// Mutation returns the IndexCounterMutation object of the builder.
+func (icuo *IndexCounterUpdateOne) Mutation() *IndexCounterMutation {
+	return icuo.mutation
+}
+
+// Where appends a list predicates to the IndexCounterUpdate builder.
+func (icuo *IndexCounterUpdateOne) Where(ps ...predicate.IndexCounter) *IndexCounterUpdateOne {
+	icuo.mutation.Where(ps...)
+	return icuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icuo *IndexCounterUpdateOne) Select(field string, fields ...string) *IndexCounterUpdateOne {
+	icuo.fields = append([]string{field}, fields...)
+	return icuo
+}
+
+// Save executes the query and returns the updated IndexCounter entity.
+func (icuo *IndexCounterUpdateOne) Save(ctx context.Context) (*IndexCounter, error) {
+	return withHooks(ctx, icuo.sqlSave, icuo.mutation, icuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icuo *IndexCounterUpdateOne) SaveX(ctx context.Context) *IndexCounter {
+	node, err := icuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icuo *IndexCounterUpdateOne) Exec(ctx context.Context) error {
+	_, err := icuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icuo *IndexCounterUpdateOne) ExecX(ctx context.Context) {
+	if err := icuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icuo *IndexCounterUpdateOne) check() error {
+	if v, ok := icuo.mutation.Name(); ok {
+		if err := indexcounter.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "IndexCounter.name": %w`, err)}
+		}
+	}
+	if v, ok := icuo.mutation.NextValue(); ok {
+		if err := indexcounter.NextValueValidator(v); err != nil {
+			return &ValidationError{Name: "next_value", err: fmt.Errorf(`ent: validator failed for field "IndexCounter.next_value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (icuo *IndexCounterUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IndexCounterUpdateOne {
+	icuo.modifiers = append(icuo.modifiers, modifiers...)
+	return icuo
+}
+
+func (icuo *IndexCounterUpdateOne) sqlSave(ctx context.Context) (_node *IndexCounter, err error) {
+	if err := icuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(indexcounter.Table, indexcounter.Columns, sqlgraph.NewFieldSpec(indexcounter.FieldID, field.TypeInt))
+	id, ok := icuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IndexCounter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, indexcounter.FieldID)
+		for _, f := range fields {
+			if !indexcounter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != indexcounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icuo.mutation.Name(); ok {
+		_spec.SetField(indexcounter.FieldName, field.TypeString, value)
+	}
+	if value, ok := icuo.mutation.NextValue(); ok {
+		_spec.SetField(indexcounter.FieldNextValue, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedNextValue(); ok {
+		_spec.AddField(indexcounter.FieldNextValue, field.TypeInt, value)
+	}
+	_spec.AddModifiers(icuo.modifiers...)
+	_node = &IndexCounter{config: icuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{indexcounter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	icuo.mutation.done = true
+	return _node, nil
+}
@@ -21,8 +21,9 @@ import (
 // LikeUpdate is the builder for updating Like entities.
 type LikeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *LikeMutation
+	hooks     []Hook
+	mutation  *LikeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the LikeUpdate builder.
@@ -122,6 +123,12 @@ func (lu *LikeUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lu *LikeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LikeUpdate {
+	lu.modifiers = append(lu.modifiers, modifiers...)
+	return lu
+}
+
 func (lu *LikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := lu.check(); err != nil {
 		return n, err
@@ -195,6 +202,7 @@ func (lu *LikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(lu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{like.Label}
@@ -210,9 +218,10 @@ func (lu *LikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // LikeUpdateOne is the builder for updating a single Like entity.
 type LikeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *LikeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *LikeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -319,6 +328,12 @@ func (luo *LikeUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (luo *LikeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LikeUpdateOne {
+	luo.modifiers = append(luo.modifiers, modifiers...)
+	return luo
+}
+
 func (luo *LikeUpdateOne) sqlSave(ctx context.Context) (_node *Like, err error) {
 	if err := luo.check(); err != nil {
 		return _node, err
@@ -409,6 +424,7 @@ func (luo *LikeUpdateOne) sqlSave(ctx context.Context) (_node *Like, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(luo.modifiers...)
 	_node = &Like{config: luo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
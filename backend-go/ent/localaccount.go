@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/google/uuid"
+)
+
+// LocalAccount is the model entity for the LocalAccount schema.
+type LocalAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// EmailVerified holds the value of the "email_verified" field.
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// VerificationCode holds the value of the "verification_code" field.
+	VerificationCode string `json:"-"`
+	// VerificationExpiresAt holds the value of the "verification_expires_at" field.
+	VerificationExpiresAt *time.Time `json:"verification_expires_at,omitempty"`
+	// TokenVersion holds the value of the "token_version" field.
+	TokenVersion int `json:"token_version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LocalAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case localaccount.FieldEmailVerified:
+			values[i] = new(sql.NullBool)
+		case localaccount.FieldTokenVersion:
+			values[i] = new(sql.NullInt64)
+		case localaccount.FieldEmail, localaccount.FieldPasswordHash, localaccount.FieldVerificationCode:
+			values[i] = new(sql.NullString)
+		case localaccount.FieldVerificationExpiresAt, localaccount.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case localaccount.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LocalAccount fields.
+func (la *LocalAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case localaccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				la.ID = *value
+			}
+		case localaccount.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				la.Email = value.String
+			}
+		case localaccount.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				la.PasswordHash = value.String
+			}
+		case localaccount.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				la.EmailVerified = value.Bool
+			}
+		case localaccount.FieldVerificationCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_code", values[i])
+			} else if value.Valid {
+				la.VerificationCode = value.String
+			}
+		case localaccount.FieldVerificationExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_expires_at", values[i])
+			} else if value.Valid {
+				la.VerificationExpiresAt = new(time.Time)
+				*la.VerificationExpiresAt = value.Time
+			}
+		case localaccount.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
+			} else if value.Valid {
+				la.TokenVersion = int(value.Int64)
+			}
+		case localaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				la.CreatedAt = value.Time
+			}
+		default:
+			la.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LocalAccount.
+// This includes values selected through modifiers, order, etc.
+func (la *LocalAccount) Value(name string) (ent.Value, error) {
+	return la.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LocalAccount.
+// Note that you need to call LocalAccount.Unwrap() before calling this method if this LocalAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (la *LocalAccount) Update() *LocalAccountUpdateOne {
+	return NewLocalAccountClient(la.config).UpdateOne(la)
+}
+
+// Unwrap unwraps the LocalAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (la *LocalAccount) Unwrap() *LocalAccount {
+	_tx, ok := la.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LocalAccount is not a transactional entity")
+	}
+	la.config.driver = _tx.drv
+	return la
+}
+
+// String implements the fmt.Stringer.
+func (la *LocalAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("LocalAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", la.ID))
+	builder.WriteString("email=")
+	builder.WriteString(la.Email)
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", la.EmailVerified))
+	builder.WriteString(", ")
+	builder.WriteString("verification_code=<sensitive>")
+	builder.WriteString(", ")
+	if v := la.VerificationExpiresAt; v != nil {
+		builder.WriteString("verification_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", la.TokenVersion))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(la.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LocalAccounts is a parsable slice of LocalAccount.
+type LocalAccounts []*LocalAccount
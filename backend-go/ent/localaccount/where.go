@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package localaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldID, id))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldEmail, v))
+}
+
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// EmailVerified applies equality check predicate on the "email_verified" field. It's identical to EmailVerifiedEQ.
+func EmailVerified(v bool) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldEmailVerified, v))
+}
+
+// VerificationCode applies equality check predicate on the "verification_code" field. It's identical to VerificationCodeEQ.
+func VerificationCode(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldVerificationCode, v))
+}
+
+// VerificationExpiresAt applies equality check predicate on the "verification_expires_at" field. It's identical to VerificationExpiresAtEQ.
+func VerificationExpiresAt(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// TokenVersion applies equality check predicate on the "token_version" field. It's identical to TokenVersionEQ.
+func TokenVersion(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldTokenVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// EmailVerifiedEQ applies the EQ predicate on the "email_verified" field.
+func EmailVerifiedEQ(v bool) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldEmailVerified, v))
+}
+
+// EmailVerifiedNEQ applies the NEQ predicate on the "email_verified" field.
+func EmailVerifiedNEQ(v bool) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldEmailVerified, v))
+}
+
+// VerificationCodeEQ applies the EQ predicate on the "verification_code" field.
+func VerificationCodeEQ(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldVerificationCode, v))
+}
+
+// VerificationCodeNEQ applies the NEQ predicate on the "verification_code" field.
+func VerificationCodeNEQ(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldVerificationCode, v))
+}
+
+// VerificationCodeIn applies the In predicate on the "verification_code" field.
+func VerificationCodeIn(vs ...string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldVerificationCode, vs...))
+}
+
+// VerificationCodeNotIn applies the NotIn predicate on the "verification_code" field.
+func VerificationCodeNotIn(vs ...string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldVerificationCode, vs...))
+}
+
+// VerificationCodeGT applies the GT predicate on the "verification_code" field.
+func VerificationCodeGT(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldVerificationCode, v))
+}
+
+// VerificationCodeGTE applies the GTE predicate on the "verification_code" field.
+func VerificationCodeGTE(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldVerificationCode, v))
+}
+
+// VerificationCodeLT applies the LT predicate on the "verification_code" field.
+func VerificationCodeLT(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldVerificationCode, v))
+}
+
+// VerificationCodeLTE applies the LTE predicate on the "verification_code" field.
+func VerificationCodeLTE(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldVerificationCode, v))
+}
+
+// VerificationCodeContains applies the Contains predicate on the "verification_code" field.
+func VerificationCodeContains(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldContains(FieldVerificationCode, v))
+}
+
+// VerificationCodeHasPrefix applies the HasPrefix predicate on the "verification_code" field.
+func VerificationCodeHasPrefix(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldHasPrefix(FieldVerificationCode, v))
+}
+
+// VerificationCodeHasSuffix applies the HasSuffix predicate on the "verification_code" field.
+func VerificationCodeHasSuffix(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldHasSuffix(FieldVerificationCode, v))
+}
+
+// VerificationCodeIsNil applies the IsNil predicate on the "verification_code" field.
+func VerificationCodeIsNil() predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIsNull(FieldVerificationCode))
+}
+
+// VerificationCodeNotNil applies the NotNil predicate on the "verification_code" field.
+func VerificationCodeNotNil() predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotNull(FieldVerificationCode))
+}
+
+// VerificationCodeEqualFold applies the EqualFold predicate on the "verification_code" field.
+func VerificationCodeEqualFold(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEqualFold(FieldVerificationCode, v))
+}
+
+// VerificationCodeContainsFold applies the ContainsFold predicate on the "verification_code" field.
+func VerificationCodeContainsFold(v string) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldContainsFold(FieldVerificationCode, v))
+}
+
+// VerificationExpiresAtEQ applies the EQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtEQ(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtNEQ applies the NEQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNEQ(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIn applies the In predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIn(vs ...time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtNotIn applies the NotIn predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotIn(vs ...time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtGT applies the GT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGT(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtGTE applies the GTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGTE(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLT applies the LT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLT(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLTE applies the LTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLTE(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIsNil applies the IsNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIsNil() predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIsNull(FieldVerificationExpiresAt))
+}
+
+// VerificationExpiresAtNotNil applies the NotNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotNil() predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotNull(FieldVerificationExpiresAt))
+}
+
+// TokenVersionEQ applies the EQ predicate on the "token_version" field.
+func TokenVersionEQ(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldTokenVersion, v))
+}
+
+// TokenVersionNEQ applies the NEQ predicate on the "token_version" field.
+func TokenVersionNEQ(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldTokenVersion, v))
+}
+
+// TokenVersionIn applies the In predicate on the "token_version" field.
+func TokenVersionIn(vs ...int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldTokenVersion, vs...))
+}
+
+// TokenVersionNotIn applies the NotIn predicate on the "token_version" field.
+func TokenVersionNotIn(vs ...int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldTokenVersion, vs...))
+}
+
+// TokenVersionGT applies the GT predicate on the "token_version" field.
+func TokenVersionGT(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldTokenVersion, v))
+}
+
+// TokenVersionGTE applies the GTE predicate on the "token_version" field.
+func TokenVersionGTE(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldTokenVersion, v))
+}
+
+// TokenVersionLT applies the LT predicate on the "token_version" field.
+func TokenVersionLT(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldTokenVersion, v))
+}
+
+// TokenVersionLTE applies the LTE predicate on the "token_version" field.
+func TokenVersionLTE(v int) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldTokenVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LocalAccount) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LocalAccount) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LocalAccount) predicate.LocalAccount {
+	return predicate.LocalAccount(sql.NotPredicates(p))
+}
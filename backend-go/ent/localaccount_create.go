@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/google/uuid"
+)
+
+// LocalAccountCreate is the builder for creating a LocalAccount entity.
+type LocalAccountCreate struct {
+	config
+	mutation *LocalAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmail sets the "email" field.
+func (lac *LocalAccountCreate) SetEmail(s string) *LocalAccountCreate {
+	lac.mutation.SetEmail(s)
+	return lac
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (lac *LocalAccountCreate) SetPasswordHash(s string) *LocalAccountCreate {
+	lac.mutation.SetPasswordHash(s)
+	return lac
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (lac *LocalAccountCreate) SetEmailVerified(b bool) *LocalAccountCreate {
+	lac.mutation.SetEmailVerified(b)
+	return lac
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (lac *LocalAccountCreate) SetNillableEmailVerified(b *bool) *LocalAccountCreate {
+	if b != nil {
+		lac.SetEmailVerified(*b)
+	}
+	return lac
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (lac *LocalAccountCreate) SetVerificationCode(s string) *LocalAccountCreate {
+	lac.mutation.SetVerificationCode(s)
+	return lac
+}
+
+// SetNillableVerificationCode sets the "verification_code" field if the given value is not nil.
+func (lac *LocalAccountCreate) SetNillableVerificationCode(s *string) *LocalAccountCreate {
+	if s != nil {
+		lac.SetVerificationCode(*s)
+	}
+	return lac
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (lac *LocalAccountCreate) SetVerificationExpiresAt(t time.Time) *LocalAccountCreate {
+	lac.mutation.SetVerificationExpiresAt(t)
+	return lac
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (lac *LocalAccountCreate) SetNillableVerificationExpiresAt(t *time.Time) *LocalAccountCreate {
+	if t != nil {
+		lac.SetVerificationExpiresAt(*t)
+	}
+	return lac
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (lac *LocalAccountCreate) SetTokenVersion(i int) *LocalAccountCreate {
+	lac.mutation.SetTokenVersion(i)
+	return lac
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (lac *LocalAccountCreate) SetNillableTokenVersion(i *int) *LocalAccountCreate {
+	if i != nil {
+		lac.SetTokenVersion(*i)
+	}
+	return lac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lac *LocalAccountCreate) SetCreatedAt(t time.Time) *LocalAccountCreate {
+	lac.mutation.SetCreatedAt(t)
+	return lac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lac *LocalAccountCreate) SetNillableCreatedAt(t *time.Time) *LocalAccountCreate {
+	if t != nil {
+		lac.SetCreatedAt(*t)
+	}
+	return lac
+}
+
+// SetID sets the "id" field.
+func (lac *LocalAccountCreate) SetID(u uuid.UUID) *LocalAccountCreate {
+	lac.mutation.SetID(u)
+	return lac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lac *LocalAccountCreate) SetNillableID(u *uuid.UUID) *LocalAccountCreate {
+	if u != nil {
+		lac.SetID(*u)
+	}
+	return lac
+}
+
+// Mutation returns the LocalAccountMutation object of the builder.
+func (lac *LocalAccountCreate) Mutation() *LocalAccountMutation {
+	return lac.mutation
+}
+
+// Save creates the LocalAccount in the database.
+func (lac *LocalAccountCreate) Save(ctx context.Context) (*LocalAccount, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LocalAccountCreate) SaveX(ctx context.Context) *LocalAccount {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LocalAccountCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LocalAccountCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LocalAccountCreate) defaults() {
+	if _, ok := lac.mutation.EmailVerified(); !ok {
+		v := localaccount.DefaultEmailVerified
+		lac.mutation.SetEmailVerified(v)
+	}
+	if _, ok := lac.mutation.TokenVersion(); !ok {
+		v := localaccount.DefaultTokenVersion
+		lac.mutation.SetTokenVersion(v)
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		v := localaccount.DefaultCreatedAt()
+		lac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lac.mutation.ID(); !ok {
+		v := localaccount.DefaultID()
+		lac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LocalAccountCreate) check() error {
+	if _, ok := lac.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "LocalAccount.email"`)}
+	}
+	if v, ok := lac.mutation.Email(); ok {
+		if err := localaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "LocalAccount.email": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.PasswordHash(); !ok {
+		return &ValidationError{Name: "password_hash", err: errors.New(`ent: missing required field "LocalAccount.password_hash"`)}
+	}
+	if _, ok := lac.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "LocalAccount.email_verified"`)}
+	}
+	if _, ok := lac.mutation.TokenVersion(); !ok {
+		return &ValidationError{Name: "token_version", err: errors.New(`ent: missing required field "LocalAccount.token_version"`)}
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LocalAccount.created_at"`)}
+	}
+	return nil
+}
+
+func (lac *LocalAccountCreate) sqlSave(ctx context.Context) (*LocalAccount, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LocalAccountCreate) createSpec() (*LocalAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LocalAccount{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(localaccount.Table, sqlgraph.NewFieldSpec(localaccount.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = lac.conflict
+	if id, ok := lac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lac.mutation.Email(); ok {
+		_spec.SetField(localaccount.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := lac.mutation.PasswordHash(); ok {
+		_spec.SetField(localaccount.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := lac.mutation.EmailVerified(); ok {
+		_spec.SetField(localaccount.FieldEmailVerified, field.TypeBool, value)
+		_node.EmailVerified = value
+	}
+	if value, ok := lac.mutation.VerificationCode(); ok {
+		_spec.SetField(localaccount.FieldVerificationCode, field.TypeString, value)
+		_node.VerificationCode = value
+	}
+	if value, ok := lac.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(localaccount.FieldVerificationExpiresAt, field.TypeTime, value)
+		_node.VerificationExpiresAt = &value
+	}
+	if value, ok := lac.mutation.TokenVersion(); ok {
+		_spec.SetField(localaccount.FieldTokenVersion, field.TypeInt, value)
+		_node.TokenVersion = value
+	}
+	if value, ok := lac.mutation.CreatedAt(); ok {
+		_spec.SetField(localaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LocalAccount.Create().
+//		SetEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LocalAccountUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (lac *LocalAccountCreate) OnConflict(opts ...sql.ConflictOption) *LocalAccountUpsertOne {
+	lac.conflict = opts
+	return &LocalAccountUpsertOne{
+		create: lac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LocalAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lac *LocalAccountCreate) OnConflictColumns(columns ...string) *LocalAccountUpsertOne {
+	lac.conflict = append(lac.conflict, sql.ConflictColumns(columns...))
+	return &LocalAccountUpsertOne{
+		create: lac,
+	}
+}
+
+type (
+	// LocalAccountUpsertOne is the builder for "upsert"-ing
+	//  one LocalAccount node.
+	LocalAccountUpsertOne struct {
+		create *LocalAccountCreate
+	}
+
+	// LocalAccountUpsert is the "OnConflict" setter.
+	LocalAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmail sets the "email" field.
+func (u *LocalAccountUpsert) SetEmail(v string) *LocalAccountUpsert {
+	u.Set(localaccount.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdateEmail() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldEmail)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *LocalAccountUpsert) SetPasswordHash(v string) *LocalAccountUpsert {
+	u.Set(localaccount.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdatePasswordHash() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldPasswordHash)
+	return u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *LocalAccountUpsert) SetEmailVerified(v bool) *LocalAccountUpsert {
+	u.Set(localaccount.FieldEmailVerified, v)
+	return u
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdateEmailVerified() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldEmailVerified)
+	return u
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (u *LocalAccountUpsert) SetVerificationCode(v string) *LocalAccountUpsert {
+	u.Set(localaccount.FieldVerificationCode, v)
+	return u
+}
+
+// UpdateVerificationCode sets the "verification_code" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdateVerificationCode() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldVerificationCode)
+	return u
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (u *LocalAccountUpsert) ClearVerificationCode() *LocalAccountUpsert {
+	u.SetNull(localaccount.FieldVerificationCode)
+	return u
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (u *LocalAccountUpsert) SetVerificationExpiresAt(v time.Time) *LocalAccountUpsert {
+	u.Set(localaccount.FieldVerificationExpiresAt, v)
+	return u
+}
+
+// UpdateVerificationExpiresAt sets the "verification_expires_at" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdateVerificationExpiresAt() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldVerificationExpiresAt)
+	return u
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (u *LocalAccountUpsert) ClearVerificationExpiresAt() *LocalAccountUpsert {
+	u.SetNull(localaccount.FieldVerificationExpiresAt)
+	return u
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (u *LocalAccountUpsert) SetTokenVersion(v int) *LocalAccountUpsert {
+	u.Set(localaccount.FieldTokenVersion, v)
+	return u
+}
+
+// UpdateTokenVersion sets the "token_version" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdateTokenVersion() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldTokenVersion)
+	return u
+}
+
+// AddTokenVersion adds v to the "token_version" field.
+func (u *LocalAccountUpsert) AddTokenVersion(v int) *LocalAccountUpsert {
+	u.Add(localaccount.FieldTokenVersion, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LocalAccountUpsert) SetCreatedAt(v time.Time) *LocalAccountUpsert {
+	u.Set(localaccount.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LocalAccountUpsert) UpdateCreatedAt() *LocalAccountUpsert {
+	u.SetExcluded(localaccount.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LocalAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(localaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LocalAccountUpsertOne) UpdateNewValues() *LocalAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(localaccount.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LocalAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LocalAccountUpsertOne) Ignore() *LocalAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LocalAccountUpsertOne) DoNothing() *LocalAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LocalAccountCreate.OnConflict
+// documentation for more info.
+func (u *LocalAccountUpsertOne) Update(set func(*LocalAccountUpsert)) *LocalAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LocalAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *LocalAccountUpsertOne) SetEmail(v string) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdateEmail() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *LocalAccountUpsertOne) SetPasswordHash(v string) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdatePasswordHash() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *LocalAccountUpsertOne) SetEmailVerified(v bool) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetEmailVerified(v)
+	})
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdateEmailVerified() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateEmailVerified()
+	})
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (u *LocalAccountUpsertOne) SetVerificationCode(v string) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetVerificationCode(v)
+	})
+}
+
+// UpdateVerificationCode sets the "verification_code" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdateVerificationCode() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateVerificationCode()
+	})
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (u *LocalAccountUpsertOne) ClearVerificationCode() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.ClearVerificationCode()
+	})
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (u *LocalAccountUpsertOne) SetVerificationExpiresAt(v time.Time) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetVerificationExpiresAt(v)
+	})
+}
+
+// UpdateVerificationExpiresAt sets the "verification_expires_at" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdateVerificationExpiresAt() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateVerificationExpiresAt()
+	})
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (u *LocalAccountUpsertOne) ClearVerificationExpiresAt() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.ClearVerificationExpiresAt()
+	})
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (u *LocalAccountUpsertOne) SetTokenVersion(v int) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetTokenVersion(v)
+	})
+}
+
+// AddTokenVersion adds v to the "token_version" field.
+func (u *LocalAccountUpsertOne) AddTokenVersion(v int) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.AddTokenVersion(v)
+	})
+}
+
+// UpdateTokenVersion sets the "token_version" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdateTokenVersion() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateTokenVersion()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LocalAccountUpsertOne) SetCreatedAt(v time.Time) *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LocalAccountUpsertOne) UpdateCreatedAt() *LocalAccountUpsertOne {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LocalAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LocalAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LocalAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LocalAccountUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LocalAccountUpsertOne.ID is not supported by MySQL driver. Use LocalAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LocalAccountUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LocalAccountCreateBulk is the builder for creating many LocalAccount entities in bulk.
+type LocalAccountCreateBulk struct {
+	config
+	err      error
+	builders []*LocalAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LocalAccount entities in the database.
+func (lacb *LocalAccountCreateBulk) Save(ctx context.Context) ([]*LocalAccount, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LocalAccount, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LocalAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LocalAccountCreateBulk) SaveX(ctx context.Context) []*LocalAccount {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LocalAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LocalAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LocalAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LocalAccountUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (lacb *LocalAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *LocalAccountUpsertBulk {
+	lacb.conflict = opts
+	return &LocalAccountUpsertBulk{
+		create: lacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LocalAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lacb *LocalAccountCreateBulk) OnConflictColumns(columns ...string) *LocalAccountUpsertBulk {
+	lacb.conflict = append(lacb.conflict, sql.ConflictColumns(columns...))
+	return &LocalAccountUpsertBulk{
+		create: lacb,
+	}
+}
+
+// LocalAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of LocalAccount nodes.
+type LocalAccountUpsertBulk struct {
+	create *LocalAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LocalAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(localaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LocalAccountUpsertBulk) UpdateNewValues() *LocalAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(localaccount.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LocalAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LocalAccountUpsertBulk) Ignore() *LocalAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LocalAccountUpsertBulk) DoNothing() *LocalAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LocalAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *LocalAccountUpsertBulk) Update(set func(*LocalAccountUpsert)) *LocalAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LocalAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *LocalAccountUpsertBulk) SetEmail(v string) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdateEmail() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *LocalAccountUpsertBulk) SetPasswordHash(v string) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdatePasswordHash() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *LocalAccountUpsertBulk) SetEmailVerified(v bool) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetEmailVerified(v)
+	})
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdateEmailVerified() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateEmailVerified()
+	})
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (u *LocalAccountUpsertBulk) SetVerificationCode(v string) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetVerificationCode(v)
+	})
+}
+
+// UpdateVerificationCode sets the "verification_code" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdateVerificationCode() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateVerificationCode()
+	})
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (u *LocalAccountUpsertBulk) ClearVerificationCode() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.ClearVerificationCode()
+	})
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (u *LocalAccountUpsertBulk) SetVerificationExpiresAt(v time.Time) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetVerificationExpiresAt(v)
+	})
+}
+
+// UpdateVerificationExpiresAt sets the "verification_expires_at" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdateVerificationExpiresAt() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateVerificationExpiresAt()
+	})
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (u *LocalAccountUpsertBulk) ClearVerificationExpiresAt() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.ClearVerificationExpiresAt()
+	})
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (u *LocalAccountUpsertBulk) SetTokenVersion(v int) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetTokenVersion(v)
+	})
+}
+
+// AddTokenVersion adds v to the "token_version" field.
+func (u *LocalAccountUpsertBulk) AddTokenVersion(v int) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.AddTokenVersion(v)
+	})
+}
+
+// UpdateTokenVersion sets the "token_version" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdateTokenVersion() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateTokenVersion()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *LocalAccountUpsertBulk) SetCreatedAt(v time.Time) *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *LocalAccountUpsertBulk) UpdateCreatedAt() *LocalAccountUpsertBulk {
+	return u.Update(func(s *LocalAccountUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LocalAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LocalAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LocalAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LocalAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// LocalAccountDelete is the builder for deleting a LocalAccount entity.
+type LocalAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *LocalAccountMutation
+}
+
+// Where appends a list predicates to the LocalAccountDelete builder.
+func (lad *LocalAccountDelete) Where(ps ...predicate.LocalAccount) *LocalAccountDelete {
+	lad.mutation.Where(ps...)
+	return lad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lad *LocalAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lad.sqlExec, lad.mutation, lad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lad *LocalAccountDelete) ExecX(ctx context.Context) int {
+	n, err := lad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lad *LocalAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(localaccount.Table, sqlgraph.NewFieldSpec(localaccount.FieldID, field.TypeUUID))
+	if ps := lad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lad.mutation.done = true
+	return affected, err
+}
+
+// LocalAccountDeleteOne is the builder for deleting a single LocalAccount entity.
+type LocalAccountDeleteOne struct {
+	lad *LocalAccountDelete
+}
+
+// Where appends a list predicates to the LocalAccountDelete builder.
+func (lado *LocalAccountDeleteOne) Where(ps ...predicate.LocalAccount) *LocalAccountDeleteOne {
+	lado.lad.mutation.Where(ps...)
+	return lado
+}
+
+// Exec executes the deletion query.
+func (lado *LocalAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := lado.lad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{localaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lado *LocalAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := lado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
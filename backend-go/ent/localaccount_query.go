@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// LocalAccountQuery is the builder for querying LocalAccount entities.
+type LocalAccountQuery struct {
+	config
+	ctx        *QueryContext
+	order      []localaccount.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LocalAccount
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LocalAccountQuery builder.
+func (laq *LocalAccountQuery) Where(ps ...predicate.LocalAccount) *LocalAccountQuery {
+	laq.predicates = append(laq.predicates, ps...)
+	return laq
+}
+
+// Limit the number of records to be returned by this query.
+func (laq *LocalAccountQuery) Limit(limit int) *LocalAccountQuery {
+	laq.ctx.Limit = &limit
+	return laq
+}
+
+// Offset to start from.
+func (laq *LocalAccountQuery) Offset(offset int) *LocalAccountQuery {
+	laq.ctx.Offset = &offset
+	return laq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (laq *LocalAccountQuery) Unique(unique bool) *LocalAccountQuery {
+	laq.ctx.Unique = &unique
+	return laq
+}
+
+// Order specifies how the records should be ordered.
+func (laq *LocalAccountQuery) Order(o ...localaccount.OrderOption) *LocalAccountQuery {
+	laq.order = append(laq.order, o...)
+	return laq
+}
+
+// First returns the first LocalAccount entity from the query.
+// Returns a *NotFoundError when no LocalAccount was found.
+func (laq *LocalAccountQuery) First(ctx context.Context) (*LocalAccount, error) {
+	nodes, err := laq.Limit(1).All(setContextOp(ctx, laq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{localaccount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (laq *LocalAccountQuery) FirstX(ctx context.Context) *LocalAccount {
+	node, err := laq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LocalAccount ID from the query.
+// Returns a *NotFoundError when no LocalAccount ID was found.
+func (laq *LocalAccountQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = laq.Limit(1).IDs(setContextOp(ctx, laq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{localaccount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (laq *LocalAccountQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := laq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LocalAccount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LocalAccount entity is found.
+// Returns a *NotFoundError when no LocalAccount entities are found.
+func (laq *LocalAccountQuery) Only(ctx context.Context) (*LocalAccount, error) {
+	nodes, err := laq.Limit(2).All(setContextOp(ctx, laq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{localaccount.Label}
+	default:
+		return nil, &NotSingularError{localaccount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (laq *LocalAccountQuery) OnlyX(ctx context.Context) *LocalAccount {
+	node, err := laq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LocalAccount ID in the query.
+// Returns a *NotSingularError when more than one LocalAccount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (laq *LocalAccountQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = laq.Limit(2).IDs(setContextOp(ctx, laq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{localaccount.Label}
+	default:
+		err = &NotSingularError{localaccount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (laq *LocalAccountQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := laq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LocalAccounts.
+func (laq *LocalAccountQuery) All(ctx context.Context) ([]*LocalAccount, error) {
+	ctx = setContextOp(ctx, laq.ctx, ent.OpQueryAll)
+	if err := laq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LocalAccount, *LocalAccountQuery]()
+	return withInterceptors[[]*LocalAccount](ctx, laq, qr, laq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (laq *LocalAccountQuery) AllX(ctx context.Context) []*LocalAccount {
+	nodes, err := laq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LocalAccount IDs.
+func (laq *LocalAccountQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if laq.ctx.Unique == nil && laq.path != nil {
+		laq.Unique(true)
+	}
+	ctx = setContextOp(ctx, laq.ctx, ent.OpQueryIDs)
+	if err = laq.Select(localaccount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (laq *LocalAccountQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := laq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (laq *LocalAccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, laq.ctx, ent.OpQueryCount)
+	if err := laq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, laq, querierCount[*LocalAccountQuery](), laq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (laq *LocalAccountQuery) CountX(ctx context.Context) int {
+	count, err := laq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (laq *LocalAccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, laq.ctx, ent.OpQueryExist)
+	switch _, err := laq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (laq *LocalAccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := laq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LocalAccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (laq *LocalAccountQuery) Clone() *LocalAccountQuery {
+	if laq == nil {
+		return nil
+	}
+	return &LocalAccountQuery{
+		config:     laq.config,
+		ctx:        laq.ctx.Clone(),
+		order:      append([]localaccount.OrderOption{}, laq.order...),
+		inters:     append([]Interceptor{}, laq.inters...),
+		predicates: append([]predicate.LocalAccount{}, laq.predicates...),
+		// clone intermediate query.
+		sql:       laq.sql.Clone(),
+		path:      laq.path,
+		modifiers: append([]func(*sql.Selector){}, laq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LocalAccount.Query().
+//		GroupBy(localaccount.FieldEmail).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (laq *LocalAccountQuery) GroupBy(field string, fields ...string) *LocalAccountGroupBy {
+	laq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LocalAccountGroupBy{build: laq}
+	grbuild.flds = &laq.ctx.Fields
+	grbuild.label = localaccount.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	client.LocalAccount.Query().
+//		Select(localaccount.FieldEmail).
+//		Scan(ctx, &v)
+func (laq *LocalAccountQuery) Select(fields ...string) *LocalAccountSelect {
+	laq.ctx.Fields = append(laq.ctx.Fields, fields...)
+	sbuild := &LocalAccountSelect{LocalAccountQuery: laq}
+	sbuild.label = localaccount.Label
+	sbuild.flds, sbuild.scan = &laq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LocalAccountSelect configured with the given aggregations.
+func (laq *LocalAccountQuery) Aggregate(fns ...AggregateFunc) *LocalAccountSelect {
+	return laq.Select().Aggregate(fns...)
+}
+
+func (laq *LocalAccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range laq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, laq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range laq.ctx.Fields {
+		if !localaccount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if laq.path != nil {
+		prev, err := laq.path(ctx)
+		if err != nil {
+			return err
+		}
+		laq.sql = prev
+	}
+	return nil
+}
+
+func (laq *LocalAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LocalAccount, error) {
+	var (
+		nodes = []*LocalAccount{}
+		_spec = laq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LocalAccount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LocalAccount{config: laq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(laq.modifiers) > 0 {
+		_spec.Modifiers = laq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, laq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (laq *LocalAccountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := laq.querySpec()
+	if len(laq.modifiers) > 0 {
+		_spec.Modifiers = laq.modifiers
+	}
+	_spec.Node.Columns = laq.ctx.Fields
+	if len(laq.ctx.Fields) > 0 {
+		_spec.Unique = laq.ctx.Unique != nil && *laq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, laq.driver, _spec)
+}
+
+func (laq *LocalAccountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(localaccount.Table, localaccount.Columns, sqlgraph.NewFieldSpec(localaccount.FieldID, field.TypeUUID))
+	_spec.From = laq.sql
+	if unique := laq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if laq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := laq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, localaccount.FieldID)
+		for i := range fields {
+			if fields[i] != localaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := laq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := laq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := laq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := laq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (laq *LocalAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(laq.driver.Dialect())
+	t1 := builder.Table(localaccount.Table)
+	columns := laq.ctx.Fields
+	if len(columns) == 0 {
+		columns = localaccount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if laq.sql != nil {
+		selector = laq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if laq.ctx.Unique != nil && *laq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range laq.modifiers {
+		m(selector)
+	}
+	for _, p := range laq.predicates {
+		p(selector)
+	}
+	for _, p := range laq.order {
+		p(selector)
+	}
+	if offset := laq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := laq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (laq *LocalAccountQuery) Modify(modifiers ...func(s *sql.Selector)) *LocalAccountSelect {
+	laq.modifiers = append(laq.modifiers, modifiers...)
+	return laq.Select()
+}
+
+// LocalAccountGroupBy is the group-by builder for LocalAccount entities.
+type LocalAccountGroupBy struct {
+	selector
+	build *LocalAccountQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lagb *LocalAccountGroupBy) Aggregate(fns ...AggregateFunc) *LocalAccountGroupBy {
+	lagb.fns = append(lagb.fns, fns...)
+	return lagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lagb *LocalAccountGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lagb.build.ctx, ent.OpQueryGroupBy)
+	if err := lagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LocalAccountQuery, *LocalAccountGroupBy](ctx, lagb.build, lagb, lagb.build.inters, v)
+}
+
+func (lagb *LocalAccountGroupBy) sqlScan(ctx context.Context, root *LocalAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lagb.fns))
+	for _, fn := range lagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lagb.flds)+len(lagb.fns))
+		for _, f := range *lagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LocalAccountSelect is the builder for selecting fields of LocalAccount entities.
+type LocalAccountSelect struct {
+	*LocalAccountQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (las *LocalAccountSelect) Aggregate(fns ...AggregateFunc) *LocalAccountSelect {
+	las.fns = append(las.fns, fns...)
+	return las
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (las *LocalAccountSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, las.ctx, ent.OpQuerySelect)
+	if err := las.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LocalAccountQuery, *LocalAccountSelect](ctx, las.LocalAccountQuery, las, las.inters, v)
+}
+
+func (las *LocalAccountSelect) sqlScan(ctx context.Context, root *LocalAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(las.fns))
+	for _, fn := range las.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*las.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := las.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (las *LocalAccountSelect) Modify(modifiers ...func(s *sql.Selector)) *LocalAccountSelect {
+	las.modifiers = append(las.modifiers, modifiers...)
+	return las
+}
@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// LocalAccountUpdate is the builder for updating LocalAccount entities.
+type LocalAccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LocalAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LocalAccountUpdate builder.
+func (lau *LocalAccountUpdate) Where(ps ...predicate.LocalAccount) *LocalAccountUpdate {
+	lau.mutation.Where(ps...)
+	return lau
+}
+
+// SetEmail sets the "email" field.
+func (lau *LocalAccountUpdate) SetEmail(s string) *LocalAccountUpdate {
+	lau.mutation.SetEmail(s)
+	return lau
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillableEmail(s *string) *LocalAccountUpdate {
+	if s != nil {
+		lau.SetEmail(*s)
+	}
+	return lau
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (lau *LocalAccountUpdate) SetPasswordHash(s string) *LocalAccountUpdate {
+	lau.mutation.SetPasswordHash(s)
+	return lau
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillablePasswordHash(s *string) *LocalAccountUpdate {
+	if s != nil {
+		lau.SetPasswordHash(*s)
+	}
+	return lau
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (lau *LocalAccountUpdate) SetEmailVerified(b bool) *LocalAccountUpdate {
+	lau.mutation.SetEmailVerified(b)
+	return lau
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillableEmailVerified(b *bool) *LocalAccountUpdate {
+	if b != nil {
+		lau.SetEmailVerified(*b)
+	}
+	return lau
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (lau *LocalAccountUpdate) SetVerificationCode(s string) *LocalAccountUpdate {
+	lau.mutation.SetVerificationCode(s)
+	return lau
+}
+
+// SetNillableVerificationCode sets the "verification_code" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillableVerificationCode(s *string) *LocalAccountUpdate {
+	if s != nil {
+		lau.SetVerificationCode(*s)
+	}
+	return lau
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (lau *LocalAccountUpdate) ClearVerificationCode() *LocalAccountUpdate {
+	lau.mutation.ClearVerificationCode()
+	return lau
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (lau *LocalAccountUpdate) SetVerificationExpiresAt(t time.Time) *LocalAccountUpdate {
+	lau.mutation.SetVerificationExpiresAt(t)
+	return lau
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillableVerificationExpiresAt(t *time.Time) *LocalAccountUpdate {
+	if t != nil {
+		lau.SetVerificationExpiresAt(*t)
+	}
+	return lau
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (lau *LocalAccountUpdate) ClearVerificationExpiresAt() *LocalAccountUpdate {
+	lau.mutation.ClearVerificationExpiresAt()
+	return lau
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (lau *LocalAccountUpdate) SetTokenVersion(i int) *LocalAccountUpdate {
+	lau.mutation.ResetTokenVersion()
+	lau.mutation.SetTokenVersion(i)
+	return lau
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillableTokenVersion(i *int) *LocalAccountUpdate {
+	if i != nil {
+		lau.SetTokenVersion(*i)
+	}
+	return lau
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (lau *LocalAccountUpdate) AddTokenVersion(i int) *LocalAccountUpdate {
+	lau.mutation.AddTokenVersion(i)
+	return lau
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lau *LocalAccountUpdate) SetCreatedAt(t time.Time) *LocalAccountUpdate {
+	lau.mutation.SetCreatedAt(t)
+	return lau
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lau *LocalAccountUpdate) SetNillableCreatedAt(t *time.Time) *LocalAccountUpdate {
+	if t != nil {
+		lau.SetCreatedAt(*t)
+	}
+	return lau
+}
+
+// Mutation returns the LocalAccountMutation object of the builder.
+func (lau *LocalAccountUpdate) Mutation() *LocalAccountMutation {
+	return lau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lau *LocalAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lau.sqlSave, lau.mutation, lau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lau *LocalAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := lau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lau *LocalAccountUpdate) Exec(ctx context.Context) error {
+	_, err := lau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lau *LocalAccountUpdate) ExecX(ctx context.Context) {
+	if err := lau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lau *LocalAccountUpdate) check() error {
+	if v, ok := lau.mutation.Email(); ok {
+		if err := localaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "LocalAccount.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lau *LocalAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LocalAccountUpdate {
+	lau.modifiers = append(lau.modifiers, modifiers...)
+	return lau
+}
+
+func (lau *LocalAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(localaccount.Table, localaccount.Columns, sqlgraph.NewFieldSpec(localaccount.FieldID, field.TypeUUID))
+	if ps := lau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lau.mutation.Email(); ok {
+		_spec.SetField(localaccount.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := lau.mutation.PasswordHash(); ok {
+		_spec.SetField(localaccount.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := lau.mutation.EmailVerified(); ok {
+		_spec.SetField(localaccount.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := lau.mutation.VerificationCode(); ok {
+		_spec.SetField(localaccount.FieldVerificationCode, field.TypeString, value)
+	}
+	if lau.mutation.VerificationCodeCleared() {
+		_spec.ClearField(localaccount.FieldVerificationCode, field.TypeString)
+	}
+	if value, ok := lau.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(localaccount.FieldVerificationExpiresAt, field.TypeTime, value)
+	}
+	if lau.mutation.VerificationExpiresAtCleared() {
+		_spec.ClearField(localaccount.FieldVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := lau.mutation.TokenVersion(); ok {
+		_spec.SetField(localaccount.FieldTokenVersion, field.TypeInt, value)
+	}
+	if value, ok := lau.mutation.AddedTokenVersion(); ok {
+		_spec.AddField(localaccount.FieldTokenVersion, field.TypeInt, value)
+	}
+	if value, ok := lau.mutation.CreatedAt(); ok {
+		_spec.SetField(localaccount.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(lau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, lau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{localaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lau.mutation.done = true
+	return n, nil
+}
+
+// LocalAccountUpdateOne is the builder for updating a single LocalAccount entity.
+type LocalAccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LocalAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEmail sets the "email" field.
+func (lauo *LocalAccountUpdateOne) SetEmail(s string) *LocalAccountUpdateOne {
+	lauo.mutation.SetEmail(s)
+	return lauo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillableEmail(s *string) *LocalAccountUpdateOne {
+	if s != nil {
+		lauo.SetEmail(*s)
+	}
+	return lauo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (lauo *LocalAccountUpdateOne) SetPasswordHash(s string) *LocalAccountUpdateOne {
+	lauo.mutation.SetPasswordHash(s)
+	return lauo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillablePasswordHash(s *string) *LocalAccountUpdateOne {
+	if s != nil {
+		lauo.SetPasswordHash(*s)
+	}
+	return lauo
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (lauo *LocalAccountUpdateOne) SetEmailVerified(b bool) *LocalAccountUpdateOne {
+	lauo.mutation.SetEmailVerified(b)
+	return lauo
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillableEmailVerified(b *bool) *LocalAccountUpdateOne {
+	if b != nil {
+		lauo.SetEmailVerified(*b)
+	}
+	return lauo
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (lauo *LocalAccountUpdateOne) SetVerificationCode(s string) *LocalAccountUpdateOne {
+	lauo.mutation.SetVerificationCode(s)
+	return lauo
+}
+
+// SetNillableVerificationCode sets the "verification_code" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillableVerificationCode(s *string) *LocalAccountUpdateOne {
+	if s != nil {
+		lauo.SetVerificationCode(*s)
+	}
+	return lauo
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (lauo *LocalAccountUpdateOne) ClearVerificationCode() *LocalAccountUpdateOne {
+	lauo.mutation.ClearVerificationCode()
+	return lauo
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (lauo *LocalAccountUpdateOne) SetVerificationExpiresAt(t time.Time) *LocalAccountUpdateOne {
+	lauo.mutation.SetVerificationExpiresAt(t)
+	return lauo
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillableVerificationExpiresAt(t *time.Time) *LocalAccountUpdateOne {
+	if t != nil {
+		lauo.SetVerificationExpiresAt(*t)
+	}
+	return lauo
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (lauo *LocalAccountUpdateOne) ClearVerificationExpiresAt() *LocalAccountUpdateOne {
+	lauo.mutation.ClearVerificationExpiresAt()
+	return lauo
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (lauo *LocalAccountUpdateOne) SetTokenVersion(i int) *LocalAccountUpdateOne {
+	lauo.mutation.ResetTokenVersion()
+	lauo.mutation.SetTokenVersion(i)
+	return lauo
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillableTokenVersion(i *int) *LocalAccountUpdateOne {
+	if i != nil {
+		lauo.SetTokenVersion(*i)
+	}
+	return lauo
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (lauo *LocalAccountUpdateOne) AddTokenVersion(i int) *LocalAccountUpdateOne {
+	lauo.mutation.AddTokenVersion(i)
+	return lauo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lauo *LocalAccountUpdateOne) SetCreatedAt(t time.Time) *LocalAccountUpdateOne {
+	lauo.mutation.SetCreatedAt(t)
+	return lauo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lauo *LocalAccountUpdateOne) SetNillableCreatedAt(t *time.Time) *LocalAccountUpdateOne {
+	if t != nil {
+		lauo.SetCreatedAt(*t)
+	}
+	return lauo
+}
+
+// Mutation returns the LocalAccountMutation object of the builder.
+func (lauo *LocalAccountUpdateOne) Mutation() *LocalAccountMutation {
+	return lauo.mutation
+}
+
+// Where appends a list predicates to the LocalAccountUpdate builder.
+func (lauo *LocalAccountUpdateOne) Where(ps ...predicate.LocalAccount) *LocalAccountUpdateOne {
+	lauo.mutation.Where(ps...)
+	return lauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lauo *LocalAccountUpdateOne) Select(field string, fields ...string) *LocalAccountUpdateOne {
+	lauo.fields = append([]string{field}, fields...)
+	return lauo
+}
+
+// Save executes the query and returns the updated LocalAccount entity.
+func (lauo *LocalAccountUpdateOne) Save(ctx context.Context) (*LocalAccount, error) {
+	return withHooks(ctx, lauo.sqlSave, lauo.mutation, lauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lauo *LocalAccountUpdateOne) SaveX(ctx context.Context) *LocalAccount {
+	node, err := lauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lauo *LocalAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := lauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lauo *LocalAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := lauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lauo *LocalAccountUpdateOne) check() error {
+	if v, ok := lauo.mutation.Email(); ok {
+		if err := localaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "LocalAccount.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lauo *LocalAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LocalAccountUpdateOne {
+	lauo.modifiers = append(lauo.modifiers, modifiers...)
+	return lauo
+}
+
+func (lauo *LocalAccountUpdateOne) sqlSave(ctx context.Context) (_node *LocalAccount, err error) {
+	if err := lauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(localaccount.Table, localaccount.Columns, sqlgraph.NewFieldSpec(localaccount.FieldID, field.TypeUUID))
+	id, ok := lauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LocalAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, localaccount.FieldID)
+		for _, f := range fields {
+			if !localaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != localaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lauo.mutation.Email(); ok {
+		_spec.SetField(localaccount.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := lauo.mutation.PasswordHash(); ok {
+		_spec.SetField(localaccount.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := lauo.mutation.EmailVerified(); ok {
+		_spec.SetField(localaccount.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := lauo.mutation.VerificationCode(); ok {
+		_spec.SetField(localaccount.FieldVerificationCode, field.TypeString, value)
+	}
+	if lauo.mutation.VerificationCodeCleared() {
+		_spec.ClearField(localaccount.FieldVerificationCode, field.TypeString)
+	}
+	if value, ok := lauo.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(localaccount.FieldVerificationExpiresAt, field.TypeTime, value)
+	}
+	if lauo.mutation.VerificationExpiresAtCleared() {
+		_spec.ClearField(localaccount.FieldVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := lauo.mutation.TokenVersion(); ok {
+		_spec.SetField(localaccount.FieldTokenVersion, field.TypeInt, value)
+	}
+	if value, ok := lauo.mutation.AddedTokenVersion(); ok {
+		_spec.AddField(localaccount.FieldTokenVersion, field.TypeInt, value)
+	}
+	if value, ok := lauo.mutation.CreatedAt(); ok {
+		_spec.SetField(localaccount.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(lauo.modifiers...)
+	_node = &LocalAccount{config: lauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{localaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lauo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -13,6 +14,10 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "content", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "comment_replies", Type: field.TypeUUID, Nullable: true},
 		{Name: "post_comments", Type: field.TypeUUID},
 		{Name: "user_comments", Type: field.TypeUUID},
 	}
@@ -22,19 +27,32 @@ var (
 		Columns:    CommentsColumns,
 		PrimaryKey: []*schema.Column{CommentsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "comments_posts_comments",
-				Columns:    []*schema.Column{CommentsColumns[3]},
+				Columns:    []*schema.Column{CommentsColumns[7]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[4]},
+				Columns:    []*schema.Column{CommentsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_post_comments_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[7], CommentsColumns[2], CommentsColumns[0]},
+			},
+		},
 	}
 	// DailyTasksColumns holds the columns for the "daily_tasks" table.
 	DailyTasksColumns = []*schema.Column{
@@ -63,6 +81,13 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dailytask_created_at_user_daily_tasks",
+				Unique:  false,
+				Columns: []*schema.Column{DailyTasksColumns[1], DailyTasksColumns[4]},
+			},
+		},
 	}
 	// FollowRelationsColumns holds the columns for the "follow_relations" table.
 	FollowRelationsColumns = []*schema.Column{
@@ -90,6 +115,25 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "followrelation_user_following_user_followers",
+				Unique:  true,
+				Columns: []*schema.Column{FollowRelationsColumns[2], FollowRelationsColumns[3]},
+			},
+		},
+	}
+	// IndexCountersColumns holds the columns for the "index_counters" table.
+	IndexCountersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "next_value", Type: field.TypeInt},
+	}
+	// IndexCountersTable holds the schema information for the "index_counters" table.
+	IndexCountersTable = &schema.Table{
+		Name:       "index_counters",
+		Columns:    IndexCountersColumns,
+		PrimaryKey: []*schema.Column{IndexCountersColumns[0]},
 	}
 	// LikesColumns holds the columns for the "likes" table.
 	LikesColumns = []*schema.Column{
@@ -125,6 +169,84 @@ var (
 			},
 		},
 	}
+	// LocalAccountsColumns holds the columns for the "local_accounts" table.
+	LocalAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "verification_code", Type: field.TypeString, Nullable: true},
+		{Name: "verification_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "token_version", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LocalAccountsTable holds the schema information for the "local_accounts" table.
+	LocalAccountsTable = &schema.Table{
+		Name:       "local_accounts",
+		Columns:    LocalAccountsColumns,
+		PrimaryKey: []*schema.Column{LocalAccountsColumns[0]},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"like", "comment", "follow"}},
+		{Name: "actor_ids", Type: field.TypeJSON},
+		{Name: "read", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "notification_actor", Type: field.TypeUUID},
+		{Name: "notification_post", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_notifications", Type: field.TypeUUID},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_actor",
+				Columns:    []*schema.Column{NotificationsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "notifications_posts_post",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_notifications_updated_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[8], NotificationsColumns[5], NotificationsColumns[0]},
+			},
+			{
+				Name:    "notification_unread_post",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[8], NotificationsColumns[1], NotificationsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "read = false AND notification_post IS NOT NULL",
+				},
+			},
+			{
+				Name:    "notification_unread_follow",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "read = false AND kind = 'follow'",
+				},
+			},
+		},
+	}
 	// PetsColumns holds the columns for the "pets" table.
 	PetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -159,6 +281,9 @@ var (
 		{Name: "image_key", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "image_deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "image_reap_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "task_score", Type: field.TypeFloat64, Nullable: true},
 		{Name: "image_feature", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(768)"}},
 		{Name: "user_posts", Type: field.TypeUUID},
 	}
@@ -170,16 +295,63 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[7]},
+				Columns:    []*schema.Column{PostsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "post_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[4], PostsColumns[0]},
+			},
+		},
+	}
+	// PushTokensColumns holds the columns for the "push_tokens" table.
+	PushTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "platform", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_push_tokens", Type: field.TypeUUID},
+	}
+	// PushTokensTable holds the schema information for the "push_tokens" table.
+	PushTokensTable = &schema.Table{
+		Name:       "push_tokens",
+		Columns:    PushTokensColumns,
+		PrimaryKey: []*schema.Column{PushTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "push_tokens_users_push_tokens",
+				Columns:    []*schema.Column{PushTokensColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
 	// TaskTypesColumns holds the columns for the "task_types" table.
 	TaskTypesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "type", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString, Unique: true},
+		{Name: "title", Type: field.TypeString, Default: ""},
+		{Name: "prompt", Type: field.TypeJSON, Nullable: true},
+		{Name: "pet_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "weight", Type: field.TypeInt, Default: 1},
+		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "text_feature", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(768)"}},
 	}
 	// TaskTypesTable holds the schema information for the "task_types" table.
@@ -196,6 +368,8 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "bio", Type: field.TypeString, Default: ""},
 		{Name: "icon_image_key", Type: field.TypeString, Nullable: true},
+		{Name: "is_admin", Type: field.TypeBool, Default: false},
+		{Name: "push_opt_outs", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -204,28 +378,66 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// TagPostsColumns holds the columns for the "tag_posts" table.
+	TagPostsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "post_id", Type: field.TypeUUID},
+	}
+	// TagPostsTable holds the schema information for the "tag_posts" table.
+	TagPostsTable = &schema.Table{
+		Name:       "tag_posts",
+		Columns:    TagPostsColumns,
+		PrimaryKey: []*schema.Column{TagPostsColumns[0], TagPostsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_posts_tag_id",
+				Columns:    []*schema.Column{TagPostsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_posts_post_id",
+				Columns:    []*schema.Column{TagPostsColumns[1]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CommentsTable,
 		DailyTasksTable,
 		FollowRelationsTable,
+		IndexCountersTable,
 		LikesTable,
+		LocalAccountsTable,
+		NotificationsTable,
 		PetsTable,
 		PostsTable,
+		PushTokensTable,
+		TagsTable,
 		TaskTypesTable,
 		UsersTable,
+		TagPostsTable,
 	}
 )
 
 func init() {
-	CommentsTable.ForeignKeys[0].RefTable = PostsTable
-	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = PostsTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
 	DailyTasksTable.ForeignKeys[0].RefTable = PostsTable
 	DailyTasksTable.ForeignKeys[1].RefTable = UsersTable
 	FollowRelationsTable.ForeignKeys[0].RefTable = UsersTable
 	FollowRelationsTable.ForeignKeys[1].RefTable = UsersTable
 	LikesTable.ForeignKeys[0].RefTable = PostsTable
 	LikesTable.ForeignKeys[1].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[1].RefTable = PostsTable
+	NotificationsTable.ForeignKeys[2].RefTable = UsersTable
 	PetsTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	PushTokensTable.ForeignKeys[0].RefTable = UsersTable
+	TagPostsTable.ForeignKeys[0].RefTable = TagsTable
+	TagPostsTable.ForeignKeys[1].RefTable = PostsTable
 }
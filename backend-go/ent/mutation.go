@@ -15,10 +15,15 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/tasktype"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
@@ -37,9 +42,14 @@ const (
 	TypeComment        = "Comment"
 	TypeDailyTask      = "DailyTask"
 	TypeFollowRelation = "FollowRelation"
+	TypeIndexCounter   = "IndexCounter"
 	TypeLike           = "Like"
+	TypeLocalAccount   = "LocalAccount"
+	TypeNotification   = "Notification"
 	TypePet            = "Pet"
 	TypePost           = "Post"
+	TypePushToken      = "PushToken"
+	TypeTag            = "Tag"
 	TypeTaskType       = "TaskType"
 	TypeUser           = "User"
 )
@@ -47,19 +57,27 @@ const (
 // CommentMutation represents an operation that mutates the Comment nodes in the graph.
 type CommentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	content       *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	post          *uuid.UUID
-	clearedpost   bool
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Comment, error)
-	predicates    []predicate.Comment
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	content        *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	edited_at      *time.Time
+	deleted_at     *time.Time
+	clearedFields  map[string]struct{}
+	post           *uuid.UUID
+	clearedpost    bool
+	user           *uuid.UUID
+	cleareduser    bool
+	parent         *uuid.UUID
+	clearedparent  bool
+	replies        map[uuid.UUID]struct{}
+	removedreplies map[uuid.UUID]struct{}
+	clearedreplies bool
+	done           bool
+	oldValue       func(context.Context) (*Comment, error)
+	predicates     []predicate.Comment
 }
 
 var _ ent.Mutation = (*CommentMutation)(nil)
@@ -202,6 +220,42 @@ func (m *CommentMutation) ResetContent() {
 	m.content = nil
 }
 
+// SetPostComments sets the "post_comments" field.
+func (m *CommentMutation) SetPostComments(u uuid.UUID) {
+	m.post = &u
+}
+
+// PostComments returns the value of the "post_comments" field in the mutation.
+func (m *CommentMutation) PostComments() (r uuid.UUID, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostComments returns the old "post_comments" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldPostComments(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostComments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostComments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostComments: %w", err)
+	}
+	return oldValue.PostComments, nil
+}
+
+// ResetPostComments resets all changes to the "post_comments" field.
+func (m *CommentMutation) ResetPostComments() {
+	m.post = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *CommentMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -238,6 +292,140 @@ func (m *CommentMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *CommentMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *CommentMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *CommentMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[comment.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *CommentMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *CommentMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, comment.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
+}
+
 // SetPostID sets the "post" edge to the Post entity by id.
 func (m *CommentMutation) SetPostID(id uuid.UUID) {
 	m.post = &id
@@ -246,6 +434,7 @@ func (m *CommentMutation) SetPostID(id uuid.UUID) {
 // ClearPost clears the "post" edge to the Post entity.
 func (m *CommentMutation) ClearPost() {
 	m.clearedpost = true
+	m.clearedFields[comment.FieldPostComments] = struct{}{}
 }
 
 // PostCleared reports if the "post" edge to the Post entity was cleared.
@@ -316,6 +505,99 @@ func (m *CommentMutation) ResetUser() {
 	m.cleareduser = false
 }
 
+// SetParentID sets the "parent" edge to the Comment entity by id.
+func (m *CommentMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *CommentMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
+func (m *CommentMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Comment entity.
+func (m *CommentMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
+func (m *CommentMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
+func (m *CommentMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *CommentMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *CommentMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
 // Where appends a list predicates to the CommentMutation builder.
 func (m *CommentMutation) Where(ps ...predicate.Comment) {
 	m.predicates = append(m.predicates, ps...)
@@ -350,13 +632,25 @@ func (m *CommentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 6)
 	if m.content != nil {
 		fields = append(fields, comment.FieldContent)
 	}
+	if m.post != nil {
+		fields = append(fields, comment.FieldPostComments)
+	}
 	if m.created_at != nil {
 		fields = append(fields, comment.FieldCreatedAt)
 	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
 	return fields
 }
 
@@ -367,8 +661,16 @@ func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case comment.FieldContent:
 		return m.Content()
+	case comment.FieldPostComments:
+		return m.PostComments()
 	case comment.FieldCreatedAt:
 		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case comment.FieldEditedAt:
+		return m.EditedAt()
+	case comment.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -380,8 +682,16 @@ func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value,
 	switch name {
 	case comment.FieldContent:
 		return m.OldContent(ctx)
+	case comment.FieldPostComments:
+		return m.OldPostComments(ctx)
 	case comment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case comment.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case comment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
@@ -398,6 +708,13 @@ func (m *CommentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetContent(v)
 		return nil
+	case comment.FieldPostComments:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostComments(v)
+		return nil
 	case comment.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -405,6 +722,27 @@ func (m *CommentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case comment.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case comment.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Comment field %s", name)
 }
@@ -434,7 +772,14 @@ func (m *CommentMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CommentMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(comment.FieldEditedAt) {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -447,6 +792,14 @@ func (m *CommentMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
@@ -457,22 +810,40 @@ func (m *CommentMutation) ResetField(name string) error {
 	case comment.FieldContent:
 		m.ResetContent()
 		return nil
+	case comment.FieldPostComments:
+		m.ResetPostComments()
+		return nil
 	case comment.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case comment.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.post != nil {
 		edges = append(edges, comment.EdgePost)
 	}
 	if m.user != nil {
 		edges = append(edges, comment.EdgeUser)
 	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
 	return edges
 }
 
@@ -488,31 +859,58 @@ func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
+	if m.removedreplies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.clearedpost {
 		edges = append(edges, comment.EdgePost)
 	}
 	if m.cleareduser {
 		edges = append(edges, comment.EdgeUser)
 	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, comment.EdgeReplies)
+	}
 	return edges
 }
 
@@ -524,6 +922,10 @@ func (m *CommentMutation) EdgeCleared(name string) bool {
 		return m.clearedpost
 	case comment.EdgeUser:
 		return m.cleareduser
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeReplies:
+		return m.clearedreplies
 	}
 	return false
 }
@@ -538,6 +940,9 @@ func (m *CommentMutation) ClearEdge(name string) error {
 	case comment.EdgeUser:
 		m.ClearUser()
 		return nil
+	case comment.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
 	return fmt.Errorf("unknown Comment unique edge %s", name)
 }
@@ -552,6 +957,12 @@ func (m *CommentMutation) ResetEdge(name string) error {
 	case comment.EdgeUser:
 		m.ResetUser()
 		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeReplies:
+		m.ResetReplies()
+		return nil
 	}
 	return fmt.Errorf("unknown Comment edge %s", name)
 }
@@ -1526,34 +1937,32 @@ func (m *FollowRelationMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown FollowRelation edge %s", name)
 }
 
-// LikeMutation represents an operation that mutates the Like nodes in the graph.
-type LikeMutation struct {
+// IndexCounterMutation represents an operation that mutates the IndexCounter nodes in the graph.
+type IndexCounterMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
+	id            *int
+	name          *string
+	next_value    *int
+	addnext_value *int
 	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	post          *uuid.UUID
-	clearedpost   bool
 	done          bool
-	oldValue      func(context.Context) (*Like, error)
-	predicates    []predicate.Like
+	oldValue      func(context.Context) (*IndexCounter, error)
+	predicates    []predicate.IndexCounter
 }
 
-var _ ent.Mutation = (*LikeMutation)(nil)
+var _ ent.Mutation = (*IndexCounterMutation)(nil)
 
-// likeOption allows management of the mutation configuration using functional options.
-type likeOption func(*LikeMutation)
+// indexcounterOption allows management of the mutation configuration using functional options.
+type indexcounterOption func(*IndexCounterMutation)
 
-// newLikeMutation creates new mutation for the Like entity.
-func newLikeMutation(c config, op Op, opts ...likeOption) *LikeMutation {
-	m := &LikeMutation{
+// newIndexCounterMutation creates new mutation for the IndexCounter entity.
+func newIndexCounterMutation(c config, op Op, opts ...indexcounterOption) *IndexCounterMutation {
+	m := &IndexCounterMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLike,
+		typ:           TypeIndexCounter,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1562,20 +1971,20 @@ func newLikeMutation(c config, op Op, opts ...likeOption) *LikeMutation {
 	return m
 }
 
-// withLikeID sets the ID field of the mutation.
-func withLikeID(id uuid.UUID) likeOption {
-	return func(m *LikeMutation) {
+// withIndexCounterID sets the ID field of the mutation.
+func withIndexCounterID(id int) indexcounterOption {
+	return func(m *IndexCounterMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Like
+			value *IndexCounter
 		)
-		m.oldValue = func(ctx context.Context) (*Like, error) {
+		m.oldValue = func(ctx context.Context) (*IndexCounter, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Like.Get(ctx, id)
+					value, err = m.Client().IndexCounter.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1584,10 +1993,10 @@ func withLikeID(id uuid.UUID) likeOption {
 	}
 }
 
-// withLike sets the old Like of the mutation.
-func withLike(node *Like) likeOption {
-	return func(m *LikeMutation) {
-		m.oldValue = func(context.Context) (*Like, error) {
+// withIndexCounter sets the old IndexCounter of the mutation.
+func withIndexCounter(node *IndexCounter) indexcounterOption {
+	return func(m *IndexCounterMutation) {
+		m.oldValue = func(context.Context) (*IndexCounter, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1596,7 +2005,7 @@ func withLike(node *Like) likeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LikeMutation) Client() *Client {
+func (m IndexCounterMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1604,7 +2013,7 @@ func (m LikeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LikeMutation) Tx() (*Tx, error) {
+func (m IndexCounterMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1613,15 +2022,9 @@ func (m LikeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Like entities.
-func (m *LikeMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LikeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *IndexCounterMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1632,144 +2035,122 @@ func (m *LikeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *IndexCounterMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Like.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().IndexCounter.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LikeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetName sets the "name" field.
+func (m *IndexCounterMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LikeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Name returns the value of the "name" field in the mutation.
+func (m *IndexCounterMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Like entity.
-// If the Like object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the IndexCounter entity.
+// If the IndexCounter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IndexCounterMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LikeMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *LikeMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+	return oldValue.Name, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *LikeMutation) ClearUser() {
-	m.cleareduser = true
+// ResetName resets all changes to the "name" field.
+func (m *IndexCounterMutation) ResetName() {
+	m.name = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *LikeMutation) UserCleared() bool {
-	return m.cleareduser
+// SetNextValue sets the "next_value" field.
+func (m *IndexCounterMutation) SetNextValue(i int) {
+	m.next_value = &i
+	m.addnext_value = nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *LikeMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// NextValue returns the value of the "next_value" field in the mutation.
+func (m *IndexCounterMutation) NextValue() (r int, exists bool) {
+	v := m.next_value
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *LikeMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldNextValue returns the old "next_value" field's value of the IndexCounter entity.
+// If the IndexCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndexCounterMutation) OldNextValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextValue is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *LikeMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// SetPostID sets the "post" edge to the Post entity by id.
-func (m *LikeMutation) SetPostID(id uuid.UUID) {
-	m.post = &id
-}
-
-// ClearPost clears the "post" edge to the Post entity.
-func (m *LikeMutation) ClearPost() {
-	m.clearedpost = true
-}
-
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *LikeMutation) PostCleared() bool {
-	return m.clearedpost
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextValue: %w", err)
+	}
+	return oldValue.NextValue, nil
 }
 
-// PostID returns the "post" edge ID in the mutation.
-func (m *LikeMutation) PostID() (id uuid.UUID, exists bool) {
-	if m.post != nil {
-		return *m.post, true
+// AddNextValue adds i to the "next_value" field.
+func (m *IndexCounterMutation) AddNextValue(i int) {
+	if m.addnext_value != nil {
+		*m.addnext_value += i
+	} else {
+		m.addnext_value = &i
 	}
-	return
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *LikeMutation) PostIDs() (ids []uuid.UUID) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
+// AddedNextValue returns the value that was added to the "next_value" field in this mutation.
+func (m *IndexCounterMutation) AddedNextValue() (r int, exists bool) {
+	v := m.addnext_value
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *LikeMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// ResetNextValue resets all changes to the "next_value" field.
+func (m *IndexCounterMutation) ResetNextValue() {
+	m.next_value = nil
+	m.addnext_value = nil
 }
 
-// Where appends a list predicates to the LikeMutation builder.
-func (m *LikeMutation) Where(ps ...predicate.Like) {
+// Where appends a list predicates to the IndexCounterMutation builder.
+func (m *IndexCounterMutation) Where(ps ...predicate.IndexCounter) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LikeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the IndexCounterMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LikeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Like, len(ps))
+func (m *IndexCounterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IndexCounter, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1777,27 +2158,30 @@ func (m *LikeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LikeMutation) Op() Op {
+func (m *IndexCounterMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LikeMutation) SetOp(op Op) {
+func (m *IndexCounterMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Like).
-func (m *LikeMutation) Type() string {
+// Type returns the node type of this mutation (IndexCounter).
+func (m *IndexCounterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LikeMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.created_at != nil {
-		fields = append(fields, like.FieldCreatedAt)
+func (m *IndexCounterMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, indexcounter.FieldName)
+	}
+	if m.next_value != nil {
+		fields = append(fields, indexcounter.FieldNextValue)
 	}
 	return fields
 }
@@ -1805,10 +2189,12 @@ func (m *LikeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LikeMutation) Field(name string) (ent.Value, bool) {
+func (m *IndexCounterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case like.FieldCreatedAt:
-		return m.CreatedAt()
+	case indexcounter.FieldName:
+		return m.Name()
+	case indexcounter.FieldNextValue:
+		return m.NextValue()
 	}
 	return nil, false
 }
@@ -1816,206 +2202,185 @@ func (m *LikeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *IndexCounterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case like.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case indexcounter.FieldName:
+		return m.OldName(ctx)
+	case indexcounter.FieldNextValue:
+		return m.OldNextValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown Like field %s", name)
+	return nil, fmt.Errorf("unknown IndexCounter field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LikeMutation) SetField(name string, value ent.Value) error {
+func (m *IndexCounterMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case like.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case indexcounter.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetName(v)
+		return nil
+	case indexcounter.FieldNextValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Like field %s", name)
+	return fmt.Errorf("unknown IndexCounter field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LikeMutation) AddedFields() []string {
-	return nil
+func (m *IndexCounterMutation) AddedFields() []string {
+	var fields []string
+	if m.addnext_value != nil {
+		fields = append(fields, indexcounter.FieldNextValue)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LikeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *IndexCounterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case indexcounter.FieldNextValue:
+		return m.AddedNextValue()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LikeMutation) AddField(name string, value ent.Value) error {
+func (m *IndexCounterMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case indexcounter.FieldNextValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextValue(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Like numeric field %s", name)
+	return fmt.Errorf("unknown IndexCounter numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LikeMutation) ClearedFields() []string {
+func (m *IndexCounterMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LikeMutation) FieldCleared(name string) bool {
+func (m *IndexCounterMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LikeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Like nullable field %s", name)
+func (m *IndexCounterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IndexCounter nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LikeMutation) ResetField(name string) error {
+func (m *IndexCounterMutation) ResetField(name string) error {
 	switch name {
-	case like.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case indexcounter.FieldName:
+		m.ResetName()
+		return nil
+	case indexcounter.FieldNextValue:
+		m.ResetNextValue()
 		return nil
 	}
-	return fmt.Errorf("unknown Like field %s", name)
+	return fmt.Errorf("unknown IndexCounter field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LikeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, like.EdgeUser)
-	}
-	if m.post != nil {
-		edges = append(edges, like.EdgePost)
-	}
+func (m *IndexCounterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LikeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case like.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case like.EdgePost:
-		if id := m.post; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *IndexCounterMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LikeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *IndexCounterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LikeMutation) RemovedIDs(name string) []ent.Value {
+func (m *IndexCounterMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LikeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, like.EdgeUser)
-	}
-	if m.clearedpost {
-		edges = append(edges, like.EdgePost)
-	}
+func (m *IndexCounterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LikeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case like.EdgeUser:
-		return m.cleareduser
-	case like.EdgePost:
-		return m.clearedpost
-	}
+func (m *IndexCounterMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LikeMutation) ClearEdge(name string) error {
-	switch name {
-	case like.EdgeUser:
-		m.ClearUser()
-		return nil
-	case like.EdgePost:
-		m.ClearPost()
-		return nil
-	}
-	return fmt.Errorf("unknown Like unique edge %s", name)
+func (m *IndexCounterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IndexCounter unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LikeMutation) ResetEdge(name string) error {
-	switch name {
-	case like.EdgeUser:
-		m.ResetUser()
-		return nil
-	case like.EdgePost:
-		m.ResetPost()
-		return nil
-	}
-	return fmt.Errorf("unknown Like edge %s", name)
+func (m *IndexCounterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IndexCounter edge %s", name)
 }
 
-// PetMutation represents an operation that mutates the Pet nodes in the graph.
-type PetMutation struct {
+// LikeMutation represents an operation that mutates the Like nodes in the graph.
+type LikeMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	name          *string
-	birth_day     *string
-	_type         *pet.Type
-	species       *pet.Species
-	image_key     *string
 	created_at    *time.Time
-	deleted_at    *time.Time
 	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
-	done          bool
-	oldValue      func(context.Context) (*Pet, error)
-	predicates    []predicate.Pet
+	user          *uuid.UUID
+	cleareduser   bool
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*Like, error)
+	predicates    []predicate.Like
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*LikeMutation)(nil)
 
-// petOption allows management of the mutation configuration using functional options.
-type petOption func(*PetMutation)
+// likeOption allows management of the mutation configuration using functional options.
+type likeOption func(*LikeMutation)
 
-// newPetMutation creates new mutation for the Pet entity.
-func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
-	m := &PetMutation{
+// newLikeMutation creates new mutation for the Like entity.
+func newLikeMutation(c config, op Op, opts ...likeOption) *LikeMutation {
+	m := &LikeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypeLike,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2024,20 +2389,20 @@ func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
 	return m
 }
 
-// withPetID sets the ID field of the mutation.
-func withPetID(id uuid.UUID) petOption {
-	return func(m *PetMutation) {
+// withLikeID sets the ID field of the mutation.
+func withLikeID(id uuid.UUID) likeOption {
+	return func(m *LikeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pet
+			value *Like
 		)
-		m.oldValue = func(ctx context.Context) (*Pet, error) {
+		m.oldValue = func(ctx context.Context) (*Like, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pet.Get(ctx, id)
+					value, err = m.Client().Like.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2046,10 +2411,10 @@ func withPetID(id uuid.UUID) petOption {
 	}
 }
 
-// withPet sets the old Pet of the mutation.
-func withPet(node *Pet) petOption {
-	return func(m *PetMutation) {
-		m.oldValue = func(context.Context) (*Pet, error) {
+// withLike sets the old Like of the mutation.
+func withLike(node *Like) likeOption {
+	return func(m *LikeMutation) {
+		m.oldValue = func(context.Context) (*Like, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2058,7 +2423,7 @@ func withPet(node *Pet) petOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m LikeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2066,7 +2431,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m LikeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2076,14 +2441,14 @@ func (m PetMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Pet entities.
-func (m *PetMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Like entities.
+func (m *LikeMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PetMutation) ID() (id uuid.UUID, exists bool) {
+func (m *LikeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2094,7 +2459,7 @@ func (m *PetMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *LikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2103,173 +2468,2171 @@ func (m *PetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Like.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PetMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *LikeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PetMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LikeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Like entity.
+// If the Like object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PetMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LikeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetBirthDay sets the "birth_day" field.
-func (m *PetMutation) SetBirthDay(s string) {
-	m.birth_day = &s
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LikeMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// BirthDay returns the value of the "birth_day" field in the mutation.
-func (m *PetMutation) BirthDay() (r string, exists bool) {
-	v := m.birth_day
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearUser clears the "user" edge to the User entity.
+func (m *LikeMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// OldBirthDay returns the old "birth_day" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldBirthDay(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthDay is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthDay requires an ID field in the mutation")
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LikeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LikeMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthDay: %w", err)
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LikeMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.BirthDay, nil
+	return
 }
 
-// ResetBirthDay resets all changes to the "birth_day" field.
-func (m *PetMutation) ResetBirthDay() {
-	m.birth_day = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *LikeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetType sets the "type" field.
-func (m *PetMutation) SetType(pe pet.Type) {
-	m._type = &pe
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *LikeMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *PetMutation) GetType() (r pet.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPost clears the "post" edge to the Post entity.
+func (m *LikeMutation) ClearPost() {
+	m.clearedpost = true
 }
 
-// OldType returns the old "type" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldType(ctx context.Context) (v pet.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *LikeMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *LikeMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
 	}
-	return oldValue.Type, nil
+	return
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *PetMutation) ResetType() {
-	m._type = nil
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *LikeMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetSpecies sets the "species" field.
-func (m *PetMutation) SetSpecies(pe pet.Species) {
-	m.species = &pe
+// ResetPost resets all changes to the "post" edge.
+func (m *LikeMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// Species returns the value of the "species" field in the mutation.
-func (m *PetMutation) Species() (r pet.Species, exists bool) {
-	v := m.species
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the LikeMutation builder.
+func (m *LikeMutation) Where(ps ...predicate.Like) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldSpecies returns the old "species" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldSpecies(ctx context.Context) (v pet.Species, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpecies is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpecies requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpecies: %w", err)
+// WhereP appends storage-level predicates to the LikeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LikeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Like, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Species, nil
+	m.Where(p...)
 }
 
-// ResetSpecies resets all changes to the "species" field.
-func (m *PetMutation) ResetSpecies() {
-	m.species = nil
+// Op returns the operation name.
+func (m *LikeMutation) Op() Op {
+	return m.op
 }
 
-// SetImageKey sets the "image_key" field.
-func (m *PetMutation) SetImageKey(s string) {
-	m.image_key = &s
+// SetOp allows setting the mutation operation.
+func (m *LikeMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ImageKey returns the value of the "image_key" field in the mutation.
-func (m *PetMutation) ImageKey() (r string, exists bool) {
-	v := m.image_key
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Like).
+func (m *LikeMutation) Type() string {
+	return m.typ
 }
 
-// OldImageKey returns the old "image_key" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LikeMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, like.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LikeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case like.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case like.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Like field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LikeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case like.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Like field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LikeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LikeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LikeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Like numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LikeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LikeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LikeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Like nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LikeMutation) ResetField(name string) error {
+	switch name {
+	case like.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Like field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LikeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, like.EdgeUser)
+	}
+	if m.post != nil {
+		edges = append(edges, like.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LikeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case like.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case like.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LikeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LikeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LikeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, like.EdgeUser)
+	}
+	if m.clearedpost {
+		edges = append(edges, like.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LikeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case like.EdgeUser:
+		return m.cleareduser
+	case like.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LikeMutation) ClearEdge(name string) error {
+	switch name {
+	case like.EdgeUser:
+		m.ClearUser()
+		return nil
+	case like.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Like unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LikeMutation) ResetEdge(name string) error {
+	switch name {
+	case like.EdgeUser:
+		m.ResetUser()
+		return nil
+	case like.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Like edge %s", name)
+}
+
+// LocalAccountMutation represents an operation that mutates the LocalAccount nodes in the graph.
+type LocalAccountMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	email                   *string
+	password_hash           *string
+	email_verified          *bool
+	verification_code       *string
+	verification_expires_at *time.Time
+	token_version           *int
+	addtoken_version        *int
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*LocalAccount, error)
+	predicates              []predicate.LocalAccount
+}
+
+var _ ent.Mutation = (*LocalAccountMutation)(nil)
+
+// localaccountOption allows management of the mutation configuration using functional options.
+type localaccountOption func(*LocalAccountMutation)
+
+// newLocalAccountMutation creates new mutation for the LocalAccount entity.
+func newLocalAccountMutation(c config, op Op, opts ...localaccountOption) *LocalAccountMutation {
+	m := &LocalAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocalAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocalAccountID sets the ID field of the mutation.
+func withLocalAccountID(id uuid.UUID) localaccountOption {
+	return func(m *LocalAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LocalAccount
+		)
+		m.oldValue = func(ctx context.Context) (*LocalAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LocalAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocalAccount sets the old LocalAccount of the mutation.
+func withLocalAccount(node *LocalAccount) localaccountOption {
+	return func(m *LocalAccountMutation) {
+		m.oldValue = func(context.Context) (*LocalAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocalAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocalAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LocalAccount entities.
+func (m *LocalAccountMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocalAccountMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocalAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LocalAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *LocalAccountMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *LocalAccountMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *LocalAccountMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *LocalAccountMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *LocalAccountMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *LocalAccountMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (m *LocalAccountMutation) SetEmailVerified(b bool) {
+	m.email_verified = &b
+}
+
+// EmailVerified returns the value of the "email_verified" field in the mutation.
+func (m *LocalAccountMutation) EmailVerified() (r bool, exists bool) {
+	v := m.email_verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailVerified returns the old "email_verified" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldEmailVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerified: %w", err)
+	}
+	return oldValue.EmailVerified, nil
+}
+
+// ResetEmailVerified resets all changes to the "email_verified" field.
+func (m *LocalAccountMutation) ResetEmailVerified() {
+	m.email_verified = nil
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (m *LocalAccountMutation) SetVerificationCode(s string) {
+	m.verification_code = &s
+}
+
+// VerificationCode returns the value of the "verification_code" field in the mutation.
+func (m *LocalAccountMutation) VerificationCode() (r string, exists bool) {
+	v := m.verification_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationCode returns the old "verification_code" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldVerificationCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationCode: %w", err)
+	}
+	return oldValue.VerificationCode, nil
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (m *LocalAccountMutation) ClearVerificationCode() {
+	m.verification_code = nil
+	m.clearedFields[localaccount.FieldVerificationCode] = struct{}{}
+}
+
+// VerificationCodeCleared returns if the "verification_code" field was cleared in this mutation.
+func (m *LocalAccountMutation) VerificationCodeCleared() bool {
+	_, ok := m.clearedFields[localaccount.FieldVerificationCode]
+	return ok
+}
+
+// ResetVerificationCode resets all changes to the "verification_code" field.
+func (m *LocalAccountMutation) ResetVerificationCode() {
+	m.verification_code = nil
+	delete(m.clearedFields, localaccount.FieldVerificationCode)
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (m *LocalAccountMutation) SetVerificationExpiresAt(t time.Time) {
+	m.verification_expires_at = &t
+}
+
+// VerificationExpiresAt returns the value of the "verification_expires_at" field in the mutation.
+func (m *LocalAccountMutation) VerificationExpiresAt() (r time.Time, exists bool) {
+	v := m.verification_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationExpiresAt returns the old "verification_expires_at" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldVerificationExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationExpiresAt: %w", err)
+	}
+	return oldValue.VerificationExpiresAt, nil
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (m *LocalAccountMutation) ClearVerificationExpiresAt() {
+	m.verification_expires_at = nil
+	m.clearedFields[localaccount.FieldVerificationExpiresAt] = struct{}{}
+}
+
+// VerificationExpiresAtCleared returns if the "verification_expires_at" field was cleared in this mutation.
+func (m *LocalAccountMutation) VerificationExpiresAtCleared() bool {
+	_, ok := m.clearedFields[localaccount.FieldVerificationExpiresAt]
+	return ok
+}
+
+// ResetVerificationExpiresAt resets all changes to the "verification_expires_at" field.
+func (m *LocalAccountMutation) ResetVerificationExpiresAt() {
+	m.verification_expires_at = nil
+	delete(m.clearedFields, localaccount.FieldVerificationExpiresAt)
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (m *LocalAccountMutation) SetTokenVersion(i int) {
+	m.token_version = &i
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *LocalAccountMutation) TokenVersion() (r int, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenVersion returns the old "token_version" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (m *LocalAccountMutation) AddTokenVersion(i int) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
+	}
+}
+
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *LocalAccountMutation) AddedTokenVersion() (r int, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *LocalAccountMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LocalAccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LocalAccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LocalAccount entity.
+// If the LocalAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LocalAccountMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the LocalAccountMutation builder.
+func (m *LocalAccountMutation) Where(ps ...predicate.LocalAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocalAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocalAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LocalAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocalAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocalAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LocalAccount).
+func (m *LocalAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocalAccountMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.email != nil {
+		fields = append(fields, localaccount.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, localaccount.FieldPasswordHash)
+	}
+	if m.email_verified != nil {
+		fields = append(fields, localaccount.FieldEmailVerified)
+	}
+	if m.verification_code != nil {
+		fields = append(fields, localaccount.FieldVerificationCode)
+	}
+	if m.verification_expires_at != nil {
+		fields = append(fields, localaccount.FieldVerificationExpiresAt)
+	}
+	if m.token_version != nil {
+		fields = append(fields, localaccount.FieldTokenVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, localaccount.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocalAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case localaccount.FieldEmail:
+		return m.Email()
+	case localaccount.FieldPasswordHash:
+		return m.PasswordHash()
+	case localaccount.FieldEmailVerified:
+		return m.EmailVerified()
+	case localaccount.FieldVerificationCode:
+		return m.VerificationCode()
+	case localaccount.FieldVerificationExpiresAt:
+		return m.VerificationExpiresAt()
+	case localaccount.FieldTokenVersion:
+		return m.TokenVersion()
+	case localaccount.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocalAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case localaccount.FieldEmail:
+		return m.OldEmail(ctx)
+	case localaccount.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case localaccount.FieldEmailVerified:
+		return m.OldEmailVerified(ctx)
+	case localaccount.FieldVerificationCode:
+		return m.OldVerificationCode(ctx)
+	case localaccount.FieldVerificationExpiresAt:
+		return m.OldVerificationExpiresAt(ctx)
+	case localaccount.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
+	case localaccount.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LocalAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocalAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case localaccount.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case localaccount.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case localaccount.FieldEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerified(v)
+		return nil
+	case localaccount.FieldVerificationCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationCode(v)
+		return nil
+	case localaccount.FieldVerificationExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationExpiresAt(v)
+		return nil
+	case localaccount.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
+		return nil
+	case localaccount.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocalAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocalAccountMutation) AddedFields() []string {
+	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, localaccount.FieldTokenVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocalAccountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case localaccount.FieldTokenVersion:
+		return m.AddedTokenVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocalAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case localaccount.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocalAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocalAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(localaccount.FieldVerificationCode) {
+		fields = append(fields, localaccount.FieldVerificationCode)
+	}
+	if m.FieldCleared(localaccount.FieldVerificationExpiresAt) {
+		fields = append(fields, localaccount.FieldVerificationExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocalAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocalAccountMutation) ClearField(name string) error {
+	switch name {
+	case localaccount.FieldVerificationCode:
+		m.ClearVerificationCode()
+		return nil
+	case localaccount.FieldVerificationExpiresAt:
+		m.ClearVerificationExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocalAccountMutation) ResetField(name string) error {
+	switch name {
+	case localaccount.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case localaccount.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case localaccount.FieldEmailVerified:
+		m.ResetEmailVerified()
+		return nil
+	case localaccount.FieldVerificationCode:
+		m.ResetVerificationCode()
+		return nil
+	case localaccount.FieldVerificationExpiresAt:
+		m.ResetVerificationExpiresAt()
+		return nil
+	case localaccount.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
+	case localaccount.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocalAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocalAccountMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocalAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocalAccountMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocalAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocalAccountMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocalAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LocalAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocalAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LocalAccount edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	kind             *notification.Kind
+	actor_ids        *[]uuid.UUID
+	appendactor_ids  []uuid.UUID
+	read             *bool
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	actor            *uuid.UUID
+	clearedactor     bool
+	post             *uuid.UUID
+	clearedpost      bool
+	done             bool
+	oldValue         func(context.Context) (*Notification, error)
+	predicates       []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserNotifications sets the "user_notifications" field.
+func (m *NotificationMutation) SetUserNotifications(u uuid.UUID) {
+	m.recipient = &u
+}
+
+// UserNotifications returns the value of the "user_notifications" field in the mutation.
+func (m *NotificationMutation) UserNotifications() (r uuid.UUID, exists bool) {
+	v := m.recipient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserNotifications returns the old "user_notifications" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUserNotifications(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserNotifications is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserNotifications requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserNotifications: %w", err)
+	}
+	return oldValue.UserNotifications, nil
+}
+
+// ResetUserNotifications resets all changes to the "user_notifications" field.
+func (m *NotificationMutation) ResetUserNotifications() {
+	m.recipient = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *NotificationMutation) SetKind(n notification.Kind) {
+	m.kind = &n
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationMutation) Kind() (r notification.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldKind(ctx context.Context) (v notification.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetActorIds sets the "actor_ids" field.
+func (m *NotificationMutation) SetActorIds(u []uuid.UUID) {
+	m.actor_ids = &u
+	m.appendactor_ids = nil
+}
+
+// ActorIds returns the value of the "actor_ids" field in the mutation.
+func (m *NotificationMutation) ActorIds() (r []uuid.UUID, exists bool) {
+	v := m.actor_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorIds returns the old "actor_ids" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldActorIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorIds: %w", err)
+	}
+	return oldValue.ActorIds, nil
+}
+
+// AppendActorIds adds u to the "actor_ids" field.
+func (m *NotificationMutation) AppendActorIds(u []uuid.UUID) {
+	m.appendactor_ids = append(m.appendactor_ids, u...)
+}
+
+// AppendedActorIds returns the list of values that were appended to the "actor_ids" field in this mutation.
+func (m *NotificationMutation) AppendedActorIds() ([]uuid.UUID, bool) {
+	if len(m.appendactor_ids) == 0 {
+		return nil, false
+	}
+	return m.appendactor_ids, true
+}
+
+// ResetActorIds resets all changes to the "actor_ids" field.
+func (m *NotificationMutation) ResetActorIds() {
+	m.actor_ids = nil
+	m.appendactor_ids = nil
+}
+
+// SetRead sets the "read" field.
+func (m *NotificationMutation) SetRead(b bool) {
+	m.read = &b
+}
+
+// Read returns the value of the "read" field in the mutation.
+func (m *NotificationMutation) Read() (r bool, exists bool) {
+	v := m.read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRead returns the old "read" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+	}
+	return oldValue.Read, nil
+}
+
+// ResetRead resets all changes to the "read" field.
+func (m *NotificationMutation) ResetRead() {
+	m.read = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by id.
+func (m *NotificationMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *NotificationMutation) ClearRecipient() {
+	m.clearedrecipient = true
+	m.clearedFields[notification.FieldUserNotifications] = struct{}{}
+}
+
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *NotificationMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *NotificationMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
+	}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *NotificationMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
+// SetActorID sets the "actor" edge to the User entity by id.
+func (m *NotificationMutation) SetActorID(id uuid.UUID) {
+	m.actor = &id
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (m *NotificationMutation) ClearActor() {
+	m.clearedactor = true
+}
+
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *NotificationMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorID returns the "actor" edge ID in the mutation.
+func (m *NotificationMutation) ActorID() (id uuid.UUID, exists bool) {
+	if m.actor != nil {
+		return *m.actor, true
+	}
+	return
+}
+
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) ActorIDs() (ids []uuid.UUID) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActor resets all changes to the "actor" edge.
+func (m *NotificationMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *NotificationMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *NotificationMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *NotificationMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *NotificationMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *NotificationMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.recipient != nil {
+		fields = append(fields, notification.FieldUserNotifications)
+	}
+	if m.kind != nil {
+		fields = append(fields, notification.FieldKind)
+	}
+	if m.actor_ids != nil {
+		fields = append(fields, notification.FieldActorIds)
+	}
+	if m.read != nil {
+		fields = append(fields, notification.FieldRead)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notification.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldUserNotifications:
+		return m.UserNotifications()
+	case notification.FieldKind:
+		return m.Kind()
+	case notification.FieldActorIds:
+		return m.ActorIds()
+	case notification.FieldRead:
+		return m.Read()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldUserNotifications:
+		return m.OldUserNotifications(ctx)
+	case notification.FieldKind:
+		return m.OldKind(ctx)
+	case notification.FieldActorIds:
+		return m.OldActorIds(ctx)
+	case notification.FieldRead:
+		return m.OldRead(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldUserNotifications:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserNotifications(v)
+		return nil
+	case notification.FieldKind:
+		v, ok := value.(notification.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notification.FieldActorIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorIds(v)
+		return nil
+	case notification.FieldRead:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRead(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldUserNotifications:
+		m.ResetUserNotifications()
+		return nil
+	case notification.FieldKind:
+		m.ResetKind()
+		return nil
+	case notification.FieldActorIds:
+		m.ResetActorIds()
+		return nil
+	case notification.FieldRead:
+		m.ResetRead()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.recipient != nil {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.actor != nil {
+		edges = append(edges, notification.EdgeActor)
+	}
+	if m.post != nil {
+		edges = append(edges, notification.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedrecipient {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.clearedactor {
+		edges = append(edges, notification.EdgeActor)
+	}
+	if m.clearedpost {
+		edges = append(edges, notification.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeRecipient:
+		return m.clearedrecipient
+	case notification.EdgeActor:
+		return m.clearedactor
+	case notification.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	case notification.EdgeActor:
+		m.ClearActor()
+		return nil
+	case notification.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	case notification.EdgeActor:
+		m.ResetActor()
+		return nil
+	case notification.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	birth_day     *string
+	_type         *pet.Type
+	species       *pet.Species
+	image_key     *string
+	created_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*Pet, error)
+	predicates    []predicate.Pet
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
+
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id uuid.UUID) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Pet entities.
+func (m *PetMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetBirthDay sets the "birth_day" field.
+func (m *PetMutation) SetBirthDay(s string) {
+	m.birth_day = &s
+}
+
+// BirthDay returns the value of the "birth_day" field in the mutation.
+func (m *PetMutation) BirthDay() (r string, exists bool) {
+	v := m.birth_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthDay returns the old "birth_day" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldBirthDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthDay: %w", err)
+	}
+	return oldValue.BirthDay, nil
+}
+
+// ResetBirthDay resets all changes to the "birth_day" field.
+func (m *PetMutation) ResetBirthDay() {
+	m.birth_day = nil
+}
+
+// SetType sets the "type" field.
+func (m *PetMutation) SetType(pe pet.Type) {
+	m._type = &pe
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *PetMutation) GetType() (r pet.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldType(ctx context.Context) (v pet.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *PetMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSpecies sets the "species" field.
+func (m *PetMutation) SetSpecies(pe pet.Species) {
+	m.species = &pe
+}
+
+// Species returns the value of the "species" field in the mutation.
+func (m *PetMutation) Species() (r pet.Species, exists bool) {
+	v := m.species
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecies returns the old "species" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldSpecies(ctx context.Context) (v pet.Species, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecies: %w", err)
+	}
+	return oldValue.Species, nil
+}
+
+// ResetSpecies resets all changes to the "species" field.
+func (m *PetMutation) ResetSpecies() {
+	m.species = nil
+}
+
+// SetImageKey sets the "image_key" field.
+func (m *PetMutation) SetImageKey(s string) {
+	m.image_key = &s
+}
+
+// ImageKey returns the value of the "image_key" field in the mutation.
+func (m *PetMutation) ImageKey() (r string, exists bool) {
+	v := m.image_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageKey returns the old "image_key" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *PetMutation) OldImageKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
@@ -2284,144 +4647,1292 @@ func (m *PetMutation) OldImageKey(ctx context.Context) (v string, err error) {
 	return oldValue.ImageKey, nil
 }
 
-// ResetImageKey resets all changes to the "image_key" field.
-func (m *PetMutation) ResetImageKey() {
-	m.image_key = nil
+// ResetImageKey resets all changes to the "image_key" field.
+func (m *PetMutation) ResetImageKey() {
+	m.image_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PetMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PetMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PetMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[pet.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PetMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[pet.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PetMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, pet.FieldDeletedAt)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *PetMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PetMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PetMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *PetMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PetMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, pet.FieldName)
+	}
+	if m.birth_day != nil {
+		fields = append(fields, pet.FieldBirthDay)
+	}
+	if m._type != nil {
+		fields = append(fields, pet.FieldType)
+	}
+	if m.species != nil {
+		fields = append(fields, pet.FieldSpecies)
+	}
+	if m.image_key != nil {
+		fields = append(fields, pet.FieldImageKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pet.FieldCreatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, pet.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldName:
+		return m.Name()
+	case pet.FieldBirthDay:
+		return m.BirthDay()
+	case pet.FieldType:
+		return m.GetType()
+	case pet.FieldSpecies:
+		return m.Species()
+	case pet.FieldImageKey:
+		return m.ImageKey()
+	case pet.FieldCreatedAt:
+		return m.CreatedAt()
+	case pet.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pet.FieldName:
+		return m.OldName(ctx)
+	case pet.FieldBirthDay:
+		return m.OldBirthDay(ctx)
+	case pet.FieldType:
+		return m.OldType(ctx)
+	case pet.FieldSpecies:
+		return m.OldSpecies(ctx)
+	case pet.FieldImageKey:
+		return m.OldImageKey(ctx)
+	case pet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pet.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pet.FieldBirthDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBirthDay(v)
+		return nil
+	case pet.FieldType:
+		v, ok := value.(pet.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case pet.FieldSpecies:
+		v, ok := value.(pet.Species)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecies(v)
+		return nil
+	case pet.FieldImageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageKey(v)
+		return nil
+	case pet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pet.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Pet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pet.FieldDeletedAt) {
+		fields = append(fields, pet.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PetMutation) ClearField(name string) error {
+	switch name {
+	case pet.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PetMutation) ResetField(name string) error {
+	switch name {
+	case pet.FieldName:
+		m.ResetName()
+		return nil
+	case pet.FieldBirthDay:
+		m.ResetBirthDay()
+		return nil
+	case pet.FieldType:
+		m.ResetType()
+		return nil
+	case pet.FieldSpecies:
+		m.ResetSpecies()
+		return nil
+	case pet.FieldImageKey:
+		m.ResetImageKey()
+		return nil
+	case pet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pet.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pet.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PetMutation) ClearEdge(name string) error {
+	switch name {
+	case pet.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PetMutation) ResetEdge(name string) error {
+	switch name {
+	case pet.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet edge %s", name)
+}
+
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	index                  *int
+	addindex               *int
+	caption                *string
+	image_key              *string
+	created_at             *time.Time
+	deleted_at             *time.Time
+	image_deleted_at       *time.Time
+	image_reap_attempts    *int
+	addimage_reap_attempts *int
+	task_score             *float64
+	addtask_score          *float64
+	image_feature          *pgvector.Vector
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	comments               map[uuid.UUID]struct{}
+	removedcomments        map[uuid.UUID]struct{}
+	clearedcomments        bool
+	likes                  map[uuid.UUID]struct{}
+	removedlikes           map[uuid.UUID]struct{}
+	clearedlikes           bool
+	daily_task             *uuid.UUID
+	cleareddaily_task      bool
+	tags                   map[uuid.UUID]struct{}
+	removedtags            map[uuid.UUID]struct{}
+	clearedtags            bool
+	done                   bool
+	oldValue               func(context.Context) (*Post, error)
+	predicates             []predicate.Post
+}
+
+var _ ent.Mutation = (*PostMutation)(nil)
+
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostID sets the ID field of the mutation.
+func withPostID(id uuid.UUID) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Post entities.
+func (m *PostMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIndex sets the "index" field.
+func (m *PostMutation) SetIndex(i int) {
+	m.index = &i
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *PostMutation) Index() (r int, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds i to the "index" field.
+func (m *PostMutation) AddIndex(i int) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *PostMutation) AddedIndex() (r int, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIndex clears the value of the "index" field.
+func (m *PostMutation) ClearIndex() {
+	m.index = nil
+	m.addindex = nil
+	m.clearedFields[post.FieldIndex] = struct{}{}
+}
+
+// IndexCleared returns if the "index" field was cleared in this mutation.
+func (m *PostMutation) IndexCleared() bool {
+	_, ok := m.clearedFields[post.FieldIndex]
+	return ok
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *PostMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+	delete(m.clearedFields, post.FieldIndex)
+}
+
+// SetCaption sets the "caption" field.
+func (m *PostMutation) SetCaption(s string) {
+	m.caption = &s
+}
+
+// Caption returns the value of the "caption" field in the mutation.
+func (m *PostMutation) Caption() (r string, exists bool) {
+	v := m.caption
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaption returns the old "caption" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCaption(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaption is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaption requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaption: %w", err)
+	}
+	return oldValue.Caption, nil
+}
+
+// ResetCaption resets all changes to the "caption" field.
+func (m *PostMutation) ResetCaption() {
+	m.caption = nil
+}
+
+// SetImageKey sets the "image_key" field.
+func (m *PostMutation) SetImageKey(s string) {
+	m.image_key = &s
+}
+
+// ImageKey returns the value of the "image_key" field in the mutation.
+func (m *PostMutation) ImageKey() (r string, exists bool) {
+	v := m.image_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageKey returns the old "image_key" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldImageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageKey: %w", err)
+	}
+	return oldValue.ImageKey, nil
+}
+
+// ResetImageKey resets all changes to the "image_key" field.
+func (m *PostMutation) ResetImageKey() {
+	m.image_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, post.FieldDeletedAt)
+}
+
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (m *PostMutation) SetImageDeletedAt(t time.Time) {
+	m.image_deleted_at = &t
+}
+
+// ImageDeletedAt returns the value of the "image_deleted_at" field in the mutation.
+func (m *PostMutation) ImageDeletedAt() (r time.Time, exists bool) {
+	v := m.image_deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageDeletedAt returns the old "image_deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldImageDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageDeletedAt: %w", err)
+	}
+	return oldValue.ImageDeletedAt, nil
+}
+
+// ClearImageDeletedAt clears the value of the "image_deleted_at" field.
+func (m *PostMutation) ClearImageDeletedAt() {
+	m.image_deleted_at = nil
+	m.clearedFields[post.FieldImageDeletedAt] = struct{}{}
+}
+
+// ImageDeletedAtCleared returns if the "image_deleted_at" field was cleared in this mutation.
+func (m *PostMutation) ImageDeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldImageDeletedAt]
+	return ok
+}
+
+// ResetImageDeletedAt resets all changes to the "image_deleted_at" field.
+func (m *PostMutation) ResetImageDeletedAt() {
+	m.image_deleted_at = nil
+	delete(m.clearedFields, post.FieldImageDeletedAt)
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (m *PostMutation) SetImageReapAttempts(i int) {
+	m.image_reap_attempts = &i
+	m.addimage_reap_attempts = nil
+}
+
+// ImageReapAttempts returns the value of the "image_reap_attempts" field in the mutation.
+func (m *PostMutation) ImageReapAttempts() (r int, exists bool) {
+	v := m.image_reap_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageReapAttempts returns the old "image_reap_attempts" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldImageReapAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageReapAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageReapAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageReapAttempts: %w", err)
+	}
+	return oldValue.ImageReapAttempts, nil
+}
+
+// AddImageReapAttempts adds i to the "image_reap_attempts" field.
+func (m *PostMutation) AddImageReapAttempts(i int) {
+	if m.addimage_reap_attempts != nil {
+		*m.addimage_reap_attempts += i
+	} else {
+		m.addimage_reap_attempts = &i
+	}
+}
+
+// AddedImageReapAttempts returns the value that was added to the "image_reap_attempts" field in this mutation.
+func (m *PostMutation) AddedImageReapAttempts() (r int, exists bool) {
+	v := m.addimage_reap_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetImageReapAttempts resets all changes to the "image_reap_attempts" field.
+func (m *PostMutation) ResetImageReapAttempts() {
+	m.image_reap_attempts = nil
+	m.addimage_reap_attempts = nil
+}
+
+// SetTaskScore sets the "task_score" field.
+func (m *PostMutation) SetTaskScore(f float64) {
+	m.task_score = &f
+	m.addtask_score = nil
+}
+
+// TaskScore returns the value of the "task_score" field in the mutation.
+func (m *PostMutation) TaskScore() (r float64, exists bool) {
+	v := m.task_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskScore returns the old "task_score" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldTaskScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskScore: %w", err)
+	}
+	return oldValue.TaskScore, nil
+}
+
+// AddTaskScore adds f to the "task_score" field.
+func (m *PostMutation) AddTaskScore(f float64) {
+	if m.addtask_score != nil {
+		*m.addtask_score += f
+	} else {
+		m.addtask_score = &f
+	}
+}
+
+// AddedTaskScore returns the value that was added to the "task_score" field in this mutation.
+func (m *PostMutation) AddedTaskScore() (r float64, exists bool) {
+	v := m.addtask_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTaskScore clears the value of the "task_score" field.
+func (m *PostMutation) ClearTaskScore() {
+	m.task_score = nil
+	m.addtask_score = nil
+	m.clearedFields[post.FieldTaskScore] = struct{}{}
+}
+
+// TaskScoreCleared returns if the "task_score" field was cleared in this mutation.
+func (m *PostMutation) TaskScoreCleared() bool {
+	_, ok := m.clearedFields[post.FieldTaskScore]
+	return ok
+}
+
+// ResetTaskScore resets all changes to the "task_score" field.
+func (m *PostMutation) ResetTaskScore() {
+	m.task_score = nil
+	m.addtask_score = nil
+	delete(m.clearedFields, post.FieldTaskScore)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PetMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetImageFeature sets the "image_feature" field.
+func (m *PostMutation) SetImageFeature(pg pgvector.Vector) {
+	m.image_feature = &pg
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PetMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ImageFeature returns the value of the "image_feature" field in the mutation.
+func (m *PostMutation) ImageFeature() (r pgvector.Vector, exists bool) {
+	v := m.image_feature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldImageFeature returns the old "image_feature" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostMutation) OldImageFeature(ctx context.Context) (v pgvector.Vector, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldImageFeature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldImageFeature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldImageFeature: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ImageFeature, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PetMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearImageFeature clears the value of the "image_feature" field.
+func (m *PostMutation) ClearImageFeature() {
+	m.image_feature = nil
+	m.clearedFields[post.FieldImageFeature] = struct{}{}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PetMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// ImageFeatureCleared returns if the "image_feature" field was cleared in this mutation.
+func (m *PostMutation) ImageFeatureCleared() bool {
+	_, ok := m.clearedFields[post.FieldImageFeature]
+	return ok
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PetMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
+// ResetImageFeature resets all changes to the "image_feature" field.
+func (m *PostMutation) ResetImageFeature() {
+	m.image_feature = nil
+	delete(m.clearedFields, post.FieldImageFeature)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PostMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PostMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PostMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PostMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
 	}
-	return oldValue.DeletedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PetMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[pet.FieldDeletedAt] = struct{}{}
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PetMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[pet.FieldDeletedAt]
-	return ok
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PetMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, pet.FieldDeletedAt)
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddLikeIDs adds the "likes" edge to the Like entity by ids.
+func (m *PostMutation) AddLikeIDs(ids ...uuid.UUID) {
+	if m.likes == nil {
+		m.likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikes clears the "likes" edge to the Like entity.
+func (m *PostMutation) ClearLikes() {
+	m.clearedlikes = true
+}
+
+// LikesCleared reports if the "likes" edge to the Like entity was cleared.
+func (m *PostMutation) LikesCleared() bool {
+	return m.clearedlikes
+}
+
+// RemoveLikeIDs removes the "likes" edge to the Like entity by IDs.
+func (m *PostMutation) RemoveLikeIDs(ids ...uuid.UUID) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikes returns the removed IDs of the "likes" edge to the Like entity.
+func (m *PostMutation) RemovedLikesIDs() (ids []uuid.UUID) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *PostMutation) LikesIDs() (ids []uuid.UUID) {
+	for id := range m.likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikes resets all changes to the "likes" edge.
+func (m *PostMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
+}
+
+// SetDailyTaskID sets the "daily_task" edge to the DailyTask entity by id.
+func (m *PostMutation) SetDailyTaskID(id uuid.UUID) {
+	m.daily_task = &id
+}
+
+// ClearDailyTask clears the "daily_task" edge to the DailyTask entity.
+func (m *PostMutation) ClearDailyTask() {
+	m.cleareddaily_task = true
+}
+
+// DailyTaskCleared reports if the "daily_task" edge to the DailyTask entity was cleared.
+func (m *PostMutation) DailyTaskCleared() bool {
+	return m.cleareddaily_task
+}
+
+// DailyTaskID returns the "daily_task" edge ID in the mutation.
+func (m *PostMutation) DailyTaskID() (id uuid.UUID, exists bool) {
+	if m.daily_task != nil {
+		return *m.daily_task, true
+	}
+	return
+}
+
+// DailyTaskIDs returns the "daily_task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DailyTaskID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) DailyTaskIDs() (ids []uuid.UUID) {
+	if id := m.daily_task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDailyTask resets all changes to the "daily_task" edge.
+func (m *PostMutation) ResetDailyTask() {
+	m.daily_task = nil
+	m.cleareddaily_task = false
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *PostMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *PetMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *PostMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *PetMutation) ClearOwner() {
-	m.clearedowner = true
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *PostMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *PetMutation) OwnerCleared() bool {
-	return m.clearedowner
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *PostMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *PetMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *PostMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *PostMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *PetMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetTags resets all changes to the "tags" edge.
+func (m *PostMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// Where appends a list predicates to the PetMutation builder.
-func (m *PetMutation) Where(ps ...predicate.Pet) {
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Pet, len(ps))
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2429,45 +5940,51 @@ func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PetMutation) Op() Op {
+func (m *PostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PetMutation) SetOp(op Op) {
+func (m *PostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Pet).
-func (m *PetMutation) Type() string {
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PetMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, pet.FieldName)
-	}
-	if m.birth_day != nil {
-		fields = append(fields, pet.FieldBirthDay)
-	}
-	if m._type != nil {
-		fields = append(fields, pet.FieldType)
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.index != nil {
+		fields = append(fields, post.FieldIndex)
 	}
-	if m.species != nil {
-		fields = append(fields, pet.FieldSpecies)
+	if m.caption != nil {
+		fields = append(fields, post.FieldCaption)
 	}
 	if m.image_key != nil {
-		fields = append(fields, pet.FieldImageKey)
+		fields = append(fields, post.FieldImageKey)
 	}
 	if m.created_at != nil {
-		fields = append(fields, pet.FieldCreatedAt)
+		fields = append(fields, post.FieldCreatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, pet.FieldDeletedAt)
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	if m.image_deleted_at != nil {
+		fields = append(fields, post.FieldImageDeletedAt)
+	}
+	if m.image_reap_attempts != nil {
+		fields = append(fields, post.FieldImageReapAttempts)
+	}
+	if m.task_score != nil {
+		fields = append(fields, post.FieldTaskScore)
+	}
+	if m.image_feature != nil {
+		fields = append(fields, post.FieldImageFeature)
 	}
 	return fields
 }
@@ -2475,22 +5992,26 @@ func (m *PetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PetMutation) Field(name string) (ent.Value, bool) {
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pet.FieldName:
-		return m.Name()
-	case pet.FieldBirthDay:
-		return m.BirthDay()
-	case pet.FieldType:
-		return m.GetType()
-	case pet.FieldSpecies:
-		return m.Species()
-	case pet.FieldImageKey:
+	case post.FieldIndex:
+		return m.Index()
+	case post.FieldCaption:
+		return m.Caption()
+	case post.FieldImageKey:
 		return m.ImageKey()
-	case pet.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		return m.CreatedAt()
-	case pet.FieldDeletedAt:
+	case post.FieldDeletedAt:
 		return m.DeletedAt()
+	case post.FieldImageDeletedAt:
+		return m.ImageDeletedAt()
+	case post.FieldImageReapAttempts:
+		return m.ImageReapAttempts()
+	case post.FieldTaskScore:
+		return m.TaskScore()
+	case post.FieldImageFeature:
+		return m.ImageFeature()
 	}
 	return nil, false
 }
@@ -2498,277 +6019,451 @@ func (m *PetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pet.FieldName:
-		return m.OldName(ctx)
-	case pet.FieldBirthDay:
-		return m.OldBirthDay(ctx)
-	case pet.FieldType:
-		return m.OldType(ctx)
-	case pet.FieldSpecies:
-		return m.OldSpecies(ctx)
-	case pet.FieldImageKey:
+	case post.FieldIndex:
+		return m.OldIndex(ctx)
+	case post.FieldCaption:
+		return m.OldCaption(ctx)
+	case post.FieldImageKey:
 		return m.OldImageKey(ctx)
-	case pet.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pet.FieldDeletedAt:
+	case post.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
+	case post.FieldImageDeletedAt:
+		return m.OldImageDeletedAt(ctx)
+	case post.FieldImageReapAttempts:
+		return m.OldImageReapAttempts(ctx)
+	case post.FieldTaskScore:
+		return m.OldTaskScore(ctx)
+	case post.FieldImageFeature:
+		return m.OldImageFeature(ctx)
 	}
-	return nil, fmt.Errorf("unknown Pet field %s", name)
+	return nil, fmt.Errorf("unknown Post field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) SetField(name string, value ent.Value) error {
+func (m *PostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pet.FieldName:
-		v, ok := value.(string)
+	case post.FieldIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetIndex(v)
 		return nil
-	case pet.FieldBirthDay:
+	case post.FieldCaption:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBirthDay(v)
+		m.SetCaption(v)
 		return nil
-	case pet.FieldType:
-		v, ok := value.(pet.Type)
+	case post.FieldImageKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetImageKey(v)
 		return nil
-	case pet.FieldSpecies:
-		v, ok := value.(pet.Species)
+	case post.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpecies(v)
+		m.SetCreatedAt(v)
 		return nil
-	case pet.FieldImageKey:
-		v, ok := value.(string)
+	case post.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageKey(v)
+		m.SetDeletedAt(v)
 		return nil
-	case pet.FieldCreatedAt:
+	case post.FieldImageDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetImageDeletedAt(v)
 		return nil
-	case pet.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case post.FieldImageReapAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetImageReapAttempts(v)
+		return nil
+	case post.FieldTaskScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskScore(v)
+		return nil
+	case post.FieldImageFeature:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageFeature(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PetMutation) AddedFields() []string {
-	return nil
+func (m *PostMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, post.FieldIndex)
+	}
+	if m.addimage_reap_attempts != nil {
+		fields = append(fields, post.FieldImageReapAttempts)
+	}
+	if m.addtask_score != nil {
+		fields = append(fields, post.FieldTaskScore)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldIndex:
+		return m.AddedIndex()
+	case post.FieldImageReapAttempts:
+		return m.AddedImageReapAttempts()
+	case post.FieldTaskScore:
+		return m.AddedTaskScore()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PetMutation) AddField(name string, value ent.Value) error {
+func (m *PostMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case post.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	case post.FieldImageReapAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImageReapAttempts(v)
+		return nil
+	case post.FieldTaskScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskScore(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Pet numeric field %s", name)
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PetMutation) ClearedFields() []string {
+func (m *PostMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(pet.FieldDeletedAt) {
-		fields = append(fields, pet.FieldDeletedAt)
+	if m.FieldCleared(post.FieldIndex) {
+		fields = append(fields, post.FieldIndex)
+	}
+	if m.FieldCleared(post.FieldDeletedAt) {
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	if m.FieldCleared(post.FieldImageDeletedAt) {
+		fields = append(fields, post.FieldImageDeletedAt)
+	}
+	if m.FieldCleared(post.FieldTaskScore) {
+		fields = append(fields, post.FieldTaskScore)
+	}
+	if m.FieldCleared(post.FieldImageFeature) {
+		fields = append(fields, post.FieldImageFeature)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PetMutation) FieldCleared(name string) bool {
+func (m *PostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PetMutation) ClearField(name string) error {
+func (m *PostMutation) ClearField(name string) error {
 	switch name {
-	case pet.FieldDeletedAt:
+	case post.FieldIndex:
+		m.ClearIndex()
+		return nil
+	case post.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case post.FieldImageDeletedAt:
+		m.ClearImageDeletedAt()
+		return nil
+	case post.FieldTaskScore:
+		m.ClearTaskScore()
+		return nil
+	case post.FieldImageFeature:
+		m.ClearImageFeature()
+		return nil
 	}
-	return fmt.Errorf("unknown Pet nullable field %s", name)
+	return fmt.Errorf("unknown Post nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PetMutation) ResetField(name string) error {
+func (m *PostMutation) ResetField(name string) error {
 	switch name {
-	case pet.FieldName:
-		m.ResetName()
-		return nil
-	case pet.FieldBirthDay:
-		m.ResetBirthDay()
-		return nil
-	case pet.FieldType:
-		m.ResetType()
+	case post.FieldIndex:
+		m.ResetIndex()
 		return nil
-	case pet.FieldSpecies:
-		m.ResetSpecies()
+	case post.FieldCaption:
+		m.ResetCaption()
 		return nil
-	case pet.FieldImageKey:
+	case post.FieldImageKey:
 		m.ResetImageKey()
 		return nil
-	case pet.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pet.FieldDeletedAt:
+	case post.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
+	case post.FieldImageDeletedAt:
+		m.ResetImageDeletedAt()
+		return nil
+	case post.FieldImageReapAttempts:
+		m.ResetImageReapAttempts()
+		return nil
+	case post.FieldTaskScore:
+		m.ResetTaskScore()
+		return nil
+	case post.FieldImageFeature:
+		m.ResetImageFeature()
+		return nil
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.owner != nil {
-		edges = append(edges, pet.EdgeOwner)
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.user != nil {
+		edges = append(edges, post.EdgeUser)
+	}
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.likes != nil {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.daily_task != nil {
+		edges = append(edges, post.EdgeDailyTask)
+	}
+	if m.tags != nil {
+		edges = append(edges, post.EdgeTags)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PetMutation) AddedIDs(name string) []ent.Value {
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pet.EdgeOwner:
-		if id := m.owner; id != nil {
+	case post.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeDailyTask:
+		if id := m.daily_task; id != nil {
 			return []ent.Value{*id}
 		}
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.removedlikes != nil {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.removedlikes))
+		for id := range m.removedlikes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedowner {
-		edges = append(edges, pet.EdgeOwner)
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleareduser {
+		edges = append(edges, post.EdgeUser)
+	}
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedlikes {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.cleareddaily_task {
+		edges = append(edges, post.EdgeDailyTask)
+	}
+	if m.clearedtags {
+		edges = append(edges, post.EdgeTags)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PetMutation) EdgeCleared(name string) bool {
+func (m *PostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pet.EdgeOwner:
-		return m.clearedowner
+	case post.EdgeUser:
+		return m.cleareduser
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeLikes:
+		return m.clearedlikes
+	case post.EdgeDailyTask:
+		return m.cleareddaily_task
+	case post.EdgeTags:
+		return m.clearedtags
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PetMutation) ClearEdge(name string) error {
+func (m *PostMutation) ClearEdge(name string) error {
 	switch name {
-	case pet.EdgeOwner:
-		m.ClearOwner()
+	case post.EdgeUser:
+		m.ClearUser()
+		return nil
+	case post.EdgeDailyTask:
+		m.ClearDailyTask()
 		return nil
 	}
-	return fmt.Errorf("unknown Pet unique edge %s", name)
+	return fmt.Errorf("unknown Post unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PetMutation) ResetEdge(name string) error {
+func (m *PostMutation) ResetEdge(name string) error {
 	switch name {
-	case pet.EdgeOwner:
-		m.ResetOwner()
+	case post.EdgeUser:
+		m.ResetUser()
+		return nil
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeLikes:
+		m.ResetLikes()
+		return nil
+	case post.EdgeDailyTask:
+		m.ResetDailyTask()
+		return nil
+	case post.EdgeTags:
+		m.ResetTags()
 		return nil
 	}
-	return fmt.Errorf("unknown Pet edge %s", name)
+	return fmt.Errorf("unknown Post edge %s", name)
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
+// PushTokenMutation represents an operation that mutates the PushToken nodes in the graph.
+type PushTokenMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	index             *int
-	addindex          *int
-	caption           *string
-	image_key         *string
-	created_at        *time.Time
-	deleted_at        *time.Time
-	image_feature     *pgvector.Vector
-	clearedFields     map[string]struct{}
-	user              *uuid.UUID
-	cleareduser       bool
-	comments          map[uuid.UUID]struct{}
-	removedcomments   map[uuid.UUID]struct{}
-	clearedcomments   bool
-	likes             map[uuid.UUID]struct{}
-	removedlikes      map[uuid.UUID]struct{}
-	clearedlikes      bool
-	daily_task        *uuid.UUID
-	cleareddaily_task bool
-	done              bool
-	oldValue          func(context.Context) (*Post, error)
-	predicates        []predicate.Post
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	token         *string
+	platform      *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PushToken, error)
+	predicates    []predicate.PushToken
 }
 
-var _ ent.Mutation = (*PostMutation)(nil)
+var _ ent.Mutation = (*PushTokenMutation)(nil)
 
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
+// pushtokenOption allows management of the mutation configuration using functional options.
+type pushtokenOption func(*PushTokenMutation)
 
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+// newPushTokenMutation creates new mutation for the PushToken entity.
+func newPushTokenMutation(c config, op Op, opts ...pushtokenOption) *PushTokenMutation {
+	m := &PushTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypePushToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2777,20 +6472,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id uuid.UUID) postOption {
-	return func(m *PostMutation) {
+// withPushTokenID sets the ID field of the mutation.
+func withPushTokenID(id uuid.UUID) pushtokenOption {
+	return func(m *PushTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *PushToken
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*PushToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().PushToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2799,10 +6494,10 @@ func withPostID(id uuid.UUID) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withPushToken sets the old PushToken of the mutation.
+func withPushToken(node *PushToken) pushtokenOption {
+	return func(m *PushTokenMutation) {
+		m.oldValue = func(context.Context) (*PushToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2811,7 +6506,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m PushTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2819,7 +6514,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m PushTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2829,188 +6524,118 @@ func (m PostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Post entities.
-func (m *PostMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of PushToken entities.
+func (m *PushTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PushTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetIndex sets the "index" field.
-func (m *PostMutation) SetIndex(i int) {
-	m.index = &i
-	m.addindex = nil
-}
-
-// Index returns the value of the "index" field in the mutation.
-func (m *PostMutation) Index() (r int, exists bool) {
-	v := m.index
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIndex returns the old "index" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldIndex(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndex requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
-	}
-	return oldValue.Index, nil
-}
-
-// AddIndex adds i to the "index" field.
-func (m *PostMutation) AddIndex(i int) {
-	if m.addindex != nil {
-		*m.addindex += i
-	} else {
-		m.addindex = &i
-	}
-}
-
-// AddedIndex returns the value that was added to the "index" field in this mutation.
-func (m *PostMutation) AddedIndex() (r int, exists bool) {
-	v := m.addindex
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearIndex clears the value of the "index" field.
-func (m *PostMutation) ClearIndex() {
-	m.index = nil
-	m.addindex = nil
-	m.clearedFields[post.FieldIndex] = struct{}{}
-}
-
-// IndexCleared returns if the "index" field was cleared in this mutation.
-func (m *PostMutation) IndexCleared() bool {
-	_, ok := m.clearedFields[post.FieldIndex]
-	return ok
+		return
+	}
+	return *m.id, true
 }
 
-// ResetIndex resets all changes to the "index" field.
-func (m *PostMutation) ResetIndex() {
-	m.index = nil
-	m.addindex = nil
-	delete(m.clearedFields, post.FieldIndex)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PushTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PushToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCaption sets the "caption" field.
-func (m *PostMutation) SetCaption(s string) {
-	m.caption = &s
+// SetToken sets the "token" field.
+func (m *PushTokenMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Caption returns the value of the "caption" field in the mutation.
-func (m *PostMutation) Caption() (r string, exists bool) {
-	v := m.caption
+// Token returns the value of the "token" field in the mutation.
+func (m *PushTokenMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaption returns the old "caption" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the PushToken entity.
+// If the PushToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCaption(ctx context.Context) (v string, err error) {
+func (m *PushTokenMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaption is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaption requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaption: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Caption, nil
+	return oldValue.Token, nil
 }
 
-// ResetCaption resets all changes to the "caption" field.
-func (m *PostMutation) ResetCaption() {
-	m.caption = nil
+// ResetToken resets all changes to the "token" field.
+func (m *PushTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetImageKey sets the "image_key" field.
-func (m *PostMutation) SetImageKey(s string) {
-	m.image_key = &s
+// SetPlatform sets the "platform" field.
+func (m *PushTokenMutation) SetPlatform(s string) {
+	m.platform = &s
 }
 
-// ImageKey returns the value of the "image_key" field in the mutation.
-func (m *PostMutation) ImageKey() (r string, exists bool) {
-	v := m.image_key
+// Platform returns the value of the "platform" field in the mutation.
+func (m *PushTokenMutation) Platform() (r string, exists bool) {
+	v := m.platform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageKey returns the old "image_key" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldPlatform returns the old "platform" field's value of the PushToken entity.
+// If the PushToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldImageKey(ctx context.Context) (v string, err error) {
+func (m *PushTokenMutation) OldPlatform(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageKey requires an ID field in the mutation")
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
 	}
-	return oldValue.ImageKey, nil
+	return oldValue.Platform, nil
 }
 
-// ResetImageKey resets all changes to the "image_key" field.
-func (m *PostMutation) ResetImageKey() {
-	m.image_key = nil
+// ResetPlatform resets all changes to the "platform" field.
+func (m *PushTokenMutation) ResetPlatform() {
+	m.platform = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
+func (m *PushTokenMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PushTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3018,10 +6643,10 @@ func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PushToken entity.
+// If the PushToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PushTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3036,303 +6661,597 @@ func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
+func (m *PushTokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PushTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PushTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PushToken entity.
+// If the PushToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PushTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PushTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldDeletedAt]
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PushTokenMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PushTokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PushTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PushTokenMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PushTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PushTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PushTokenMutation builder.
+func (m *PushTokenMutation) Where(ps ...predicate.PushToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PushTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PushTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PushToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PushTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PushTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PushToken).
+func (m *PushTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PushTokenMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token != nil {
+		fields = append(fields, pushtoken.FieldToken)
+	}
+	if m.platform != nil {
+		fields = append(fields, pushtoken.FieldPlatform)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pushtoken.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pushtoken.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PushTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pushtoken.FieldToken:
+		return m.Token()
+	case pushtoken.FieldPlatform:
+		return m.Platform()
+	case pushtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case pushtoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PushTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pushtoken.FieldToken:
+		return m.OldToken(ctx)
+	case pushtoken.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case pushtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pushtoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PushToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pushtoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case pushtoken.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case pushtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pushtoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PushToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PushTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PushTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PushToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PushTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PushTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, post.FieldDeletedAt)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PushTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PushToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PushTokenMutation) ResetField(name string) error {
+	switch name {
+	case pushtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case pushtoken.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case pushtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pushtoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PushToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PushTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, pushtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PushTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pushtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PushTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetImageFeature sets the "image_feature" field.
-func (m *PostMutation) SetImageFeature(pg pgvector.Vector) {
-	m.image_feature = &pg
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PushTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ImageFeature returns the value of the "image_feature" field in the mutation.
-func (m *PostMutation) ImageFeature() (r pgvector.Vector, exists bool) {
-	v := m.image_feature
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PushTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, pushtoken.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldImageFeature returns the old "image_feature" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldImageFeature(ctx context.Context) (v pgvector.Vector, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageFeature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageFeature requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageFeature: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PushTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pushtoken.EdgeUser:
+		return m.cleareduser
 	}
-	return oldValue.ImageFeature, nil
+	return false
 }
 
-// ClearImageFeature clears the value of the "image_feature" field.
-func (m *PostMutation) ClearImageFeature() {
-	m.image_feature = nil
-	m.clearedFields[post.FieldImageFeature] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PushTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case pushtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PushToken unique edge %s", name)
 }
 
-// ImageFeatureCleared returns if the "image_feature" field was cleared in this mutation.
-func (m *PostMutation) ImageFeatureCleared() bool {
-	_, ok := m.clearedFields[post.FieldImageFeature]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PushTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case pushtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PushToken edge %s", name)
 }
 
-// ResetImageFeature resets all changes to the "image_feature" field.
-func (m *PostMutation) ResetImageFeature() {
-	m.image_feature = nil
-	delete(m.clearedFields, post.FieldImageFeature)
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	posts         map[uuid.UUID]struct{}
+	removedposts  map[uuid.UUID]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PostMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
-}
+var _ ent.Mutation = (*TagMutation)(nil)
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PostMutation) ClearUser() {
-	m.cleareduser = true
-}
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PostMutation) UserCleared() bool {
-	return m.cleareduser
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *PostMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// withTagID sets the ID field of the mutation.
+func withTagID(id uuid.UUID) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PostMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *PostMutation) ClearComments() {
-	m.clearedcomments = true
-}
-
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *PostMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *PostMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddLikeIDs adds the "likes" edge to the Like entity by ids.
-func (m *PostMutation) AddLikeIDs(ids ...uuid.UUID) {
-	if m.likes == nil {
-		m.likes = make(map[uuid.UUID]struct{})
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.likes[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearLikes clears the "likes" edge to the Like entity.
-func (m *PostMutation) ClearLikes() {
-	m.clearedlikes = true
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
 }
 
-// LikesCleared reports if the "likes" edge to the Like entity was cleared.
-func (m *PostMutation) LikesCleared() bool {
-	return m.clearedlikes
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveLikeIDs removes the "likes" edge to the Like entity by IDs.
-func (m *PostMutation) RemoveLikeIDs(ids ...uuid.UUID) {
-	if m.removedlikes == nil {
-		m.removedlikes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.likes, ids[i])
-		m.removedlikes[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedLikes returns the removed IDs of the "likes" edge to the Like entity.
-func (m *PostMutation) RemovedLikesIDs() (ids []uuid.UUID) {
-	for id := range m.removedlikes {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// LikesIDs returns the "likes" edge IDs in the mutation.
-func (m *PostMutation) LikesIDs() (ids []uuid.UUID) {
-	for id := range m.likes {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLikes resets all changes to the "likes" edge.
-func (m *PostMutation) ResetLikes() {
-	m.likes = nil
-	m.clearedlikes = false
-	m.removedlikes = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDailyTaskID sets the "daily_task" edge to the DailyTask entity by id.
-func (m *PostMutation) SetDailyTaskID(id uuid.UUID) {
-	m.daily_task = &id
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *TagMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
 }
 
-// ClearDailyTask clears the "daily_task" edge to the DailyTask entity.
-func (m *PostMutation) ClearDailyTask() {
-	m.cleareddaily_task = true
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *TagMutation) ClearPosts() {
+	m.clearedposts = true
 }
 
-// DailyTaskCleared reports if the "daily_task" edge to the DailyTask entity was cleared.
-func (m *PostMutation) DailyTaskCleared() bool {
-	return m.cleareddaily_task
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *TagMutation) PostsCleared() bool {
+	return m.clearedposts
 }
 
-// DailyTaskID returns the "daily_task" edge ID in the mutation.
-func (m *PostMutation) DailyTaskID() (id uuid.UUID, exists bool) {
-	if m.daily_task != nil {
-		return *m.daily_task, true
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *TagMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *TagMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// DailyTaskIDs returns the "daily_task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DailyTaskID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) DailyTaskIDs() (ids []uuid.UUID) {
-	if id := m.daily_task; id != nil {
-		ids = append(ids, *id)
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *TagMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDailyTask resets all changes to the "daily_task" edge.
-func (m *PostMutation) ResetDailyTask() {
-	m.daily_task = nil
-	m.cleareddaily_task = false
+// ResetPosts resets all changes to the "posts" edge.
+func (m *TagMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
 }
 
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3340,42 +7259,30 @@ func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *TagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *TagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.index != nil {
-		fields = append(fields, post.FieldIndex)
-	}
-	if m.caption != nil {
-		fields = append(fields, post.FieldCaption)
-	}
-	if m.image_key != nil {
-		fields = append(fields, post.FieldImageKey)
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	if m.image_feature != nil {
-		fields = append(fields, post.FieldImageFeature)
+		fields = append(fields, tag.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3383,20 +7290,12 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldIndex:
-		return m.Index()
-	case post.FieldCaption:
-		return m.Caption()
-	case post.FieldImageKey:
-		return m.ImageKey()
-	case post.FieldCreatedAt:
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldCreatedAt:
 		return m.CreatedAt()
-	case post.FieldDeletedAt:
-		return m.DeletedAt()
-	case post.FieldImageFeature:
-		return m.ImageFeature()
 	}
 	return nil, false
 }
@@ -3404,249 +7303,133 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldIndex:
-		return m.OldIndex(ctx)
-	case post.FieldCaption:
-		return m.OldCaption(ctx)
-	case post.FieldImageKey:
-		return m.OldImageKey(ctx)
-	case post.FieldCreatedAt:
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case post.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case post.FieldImageFeature:
-		return m.OldImageFeature(ctx)
 	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIndex(v)
-		return nil
-	case post.FieldCaption:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCaption(v)
-		return nil
-	case post.FieldImageKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImageKey(v)
+		m.SetName(v)
 		return nil
-	case post.FieldCreatedAt:
+	case tag.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case post.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case post.FieldImageFeature:
-		v, ok := value.(pgvector.Vector)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImageFeature(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostMutation) AddedFields() []string {
-	var fields []string
-	if m.addindex != nil {
-		fields = append(fields, post.FieldIndex)
-	}
-	return fields
+func (m *TagMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case post.FieldIndex:
-		return m.AddedIndex()
-	}
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *TagMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIndex(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(post.FieldIndex) {
-		fields = append(fields, post.FieldIndex)
-	}
-	if m.FieldCleared(post.FieldDeletedAt) {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	if m.FieldCleared(post.FieldImageFeature) {
-		fields = append(fields, post.FieldImageFeature)
-	}
-	return fields
+func (m *TagMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
-	switch name {
-	case post.FieldIndex:
-		m.ClearIndex()
-		return nil
-	case post.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case post.FieldImageFeature:
-		m.ClearImageFeature()
-		return nil
-	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldIndex:
-		m.ResetIndex()
-		return nil
-	case post.FieldCaption:
-		m.ResetCaption()
-		return nil
-	case post.FieldImageKey:
-		m.ResetImageKey()
+	case tag.FieldName:
+		m.ResetName()
 		return nil
-	case post.FieldCreatedAt:
+	case tag.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case post.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case post.FieldImageFeature:
-		m.ResetImageFeature()
-		return nil
-	}
-	return fmt.Errorf("unknown Post field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.user != nil {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.comments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.likes != nil {
-		edges = append(edges, post.EdgeLikes)
-	}
-	if m.daily_task != nil {
-		edges = append(edges, post.EdgeDailyTask)
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case post.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.likes))
-		for id := range m.likes {
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, tag.EdgePosts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
 			ids = append(ids, id)
 		}
 		return ids
-	case post.EdgeDailyTask:
-		if id := m.daily_task; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedcomments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.removedlikes != nil {
-		edges = append(edges, post.EdgeLikes)
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, tag.EdgePosts)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.removedlikes))
-		for id := range m.removedlikes {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3655,85 +7438,62 @@ func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleareduser {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.clearedcomments {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.clearedlikes {
-		edges = append(edges, post.EdgeLikes)
-	}
-	if m.cleareddaily_task {
-		edges = append(edges, post.EdgeDailyTask)
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, tag.EdgePosts)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeUser:
-		return m.cleareduser
-	case post.EdgeComments:
-		return m.clearedcomments
-	case post.EdgeLikes:
-		return m.clearedlikes
-	case post.EdgeDailyTask:
-		return m.cleareddaily_task
+	case tag.EdgePosts:
+		return m.clearedposts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
-		m.ClearUser()
-		return nil
-	case post.EdgeDailyTask:
-		m.ClearDailyTask()
-		return nil
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
-		m.ResetUser()
-		return nil
-	case post.EdgeComments:
-		m.ResetComments()
-		return nil
-	case post.EdgeLikes:
-		m.ResetLikes()
-		return nil
-	case post.EdgeDailyTask:
-		m.ResetDailyTask()
+	case tag.EdgePosts:
+		m.ResetPosts()
 		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
 // TaskTypeMutation represents an operation that mutates the TaskType nodes in the graph.
 type TaskTypeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	_type         *enum.TaskType
-	text_feature  *pgvector.Vector
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*TaskType, error)
-	predicates    []predicate.TaskType
+	op              Op
+	typ             string
+	id              *int
+	_type           *enum.TaskType
+	title           *string
+	prompt          *map[string]string
+	pet_types       *[]string
+	appendpet_types []string
+	weight          *int
+	addweight       *int
+	active          *bool
+	text_feature    *pgvector.Vector
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*TaskType, error)
+	predicates      []predicate.TaskType
 }
 
 var _ ent.Mutation = (*TaskTypeMutation)(nil)
@@ -3787,87 +7547,329 @@ func withTaskType(node *TaskType) tasktypeOption {
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskTypeMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskTypeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskTypeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *TaskTypeMutation) SetType(et enum.TaskType) {
+	m._type = &et
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TaskTypeMutation) GetType() (r enum.TaskType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the TaskType entity.
+// If the TaskType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTypeMutation) OldType(ctx context.Context) (v enum.TaskType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TaskTypeMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TaskTypeMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskTypeMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TaskType entity.
+// If the TaskType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTypeMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskTypeMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetPrompt sets the "prompt" field.
+func (m *TaskTypeMutation) SetPrompt(value map[string]string) {
+	m.prompt = &value
+}
+
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *TaskTypeMutation) Prompt() (r map[string]string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrompt returns the old "prompt" field's value of the TaskType entity.
+// If the TaskType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTypeMutation) OldPrompt(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (m *TaskTypeMutation) ClearPrompt() {
+	m.prompt = nil
+	m.clearedFields[tasktype.FieldPrompt] = struct{}{}
+}
+
+// PromptCleared returns if the "prompt" field was cleared in this mutation.
+func (m *TaskTypeMutation) PromptCleared() bool {
+	_, ok := m.clearedFields[tasktype.FieldPrompt]
+	return ok
+}
+
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *TaskTypeMutation) ResetPrompt() {
+	m.prompt = nil
+	delete(m.clearedFields, tasktype.FieldPrompt)
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (m *TaskTypeMutation) SetPetTypes(s []string) {
+	m.pet_types = &s
+	m.appendpet_types = nil
+}
+
+// PetTypes returns the value of the "pet_types" field in the mutation.
+func (m *TaskTypeMutation) PetTypes() (r []string, exists bool) {
+	v := m.pet_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPetTypes returns the old "pet_types" field's value of the TaskType entity.
+// If the TaskType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTypeMutation) OldPetTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPetTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPetTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPetTypes: %w", err)
+	}
+	return oldValue.PetTypes, nil
+}
+
+// AppendPetTypes adds s to the "pet_types" field.
+func (m *TaskTypeMutation) AppendPetTypes(s []string) {
+	m.appendpet_types = append(m.appendpet_types, s...)
+}
+
+// AppendedPetTypes returns the list of values that were appended to the "pet_types" field in this mutation.
+func (m *TaskTypeMutation) AppendedPetTypes() ([]string, bool) {
+	if len(m.appendpet_types) == 0 {
+		return nil, false
+	}
+	return m.appendpet_types, true
+}
+
+// ClearPetTypes clears the value of the "pet_types" field.
+func (m *TaskTypeMutation) ClearPetTypes() {
+	m.pet_types = nil
+	m.appendpet_types = nil
+	m.clearedFields[tasktype.FieldPetTypes] = struct{}{}
+}
+
+// PetTypesCleared returns if the "pet_types" field was cleared in this mutation.
+func (m *TaskTypeMutation) PetTypesCleared() bool {
+	_, ok := m.clearedFields[tasktype.FieldPetTypes]
+	return ok
+}
+
+// ResetPetTypes resets all changes to the "pet_types" field.
+func (m *TaskTypeMutation) ResetPetTypes() {
+	m.pet_types = nil
+	m.appendpet_types = nil
+	delete(m.clearedFields, tasktype.FieldPetTypes)
+}
+
+// SetWeight sets the "weight" field.
+func (m *TaskTypeMutation) SetWeight(i int) {
+	m.weight = &i
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *TaskTypeMutation) Weight() (r int, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the TaskType entity.
+// If the TaskType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTypeMutation) OldWeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TaskTypeMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddWeight adds i to the "weight" field.
+func (m *TaskTypeMutation) AddWeight(i int) {
+	if m.addweight != nil {
+		*m.addweight += i
+	} else {
+		m.addweight = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TaskTypeMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *TaskTypeMutation) AddedWeight() (r int, exists bool) {
+	v := m.addweight
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TaskTypeMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TaskType.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetWeight resets all changes to the "weight" field.
+func (m *TaskTypeMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
 }
 
-// SetType sets the "type" field.
-func (m *TaskTypeMutation) SetType(et enum.TaskType) {
-	m._type = &et
+// SetActive sets the "active" field.
+func (m *TaskTypeMutation) SetActive(b bool) {
+	m.active = &b
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TaskTypeMutation) GetType() (r enum.TaskType, exists bool) {
-	v := m._type
+// Active returns the value of the "active" field in the mutation.
+func (m *TaskTypeMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the TaskType entity.
+// OldActive returns the old "active" field's value of the TaskType entity.
 // If the TaskType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskTypeMutation) OldType(ctx context.Context) (v enum.TaskType, err error) {
+func (m *TaskTypeMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Active, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TaskTypeMutation) ResetType() {
-	m._type = nil
+// ResetActive resets all changes to the "active" field.
+func (m *TaskTypeMutation) ResetActive() {
+	m.active = nil
 }
 
 // SetTextFeature sets the "text_feature" field.
@@ -3953,10 +7955,25 @@ func (m *TaskTypeMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TaskTypeMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 7)
 	if m._type != nil {
 		fields = append(fields, tasktype.FieldType)
 	}
+	if m.title != nil {
+		fields = append(fields, tasktype.FieldTitle)
+	}
+	if m.prompt != nil {
+		fields = append(fields, tasktype.FieldPrompt)
+	}
+	if m.pet_types != nil {
+		fields = append(fields, tasktype.FieldPetTypes)
+	}
+	if m.weight != nil {
+		fields = append(fields, tasktype.FieldWeight)
+	}
+	if m.active != nil {
+		fields = append(fields, tasktype.FieldActive)
+	}
 	if m.text_feature != nil {
 		fields = append(fields, tasktype.FieldTextFeature)
 	}
@@ -3970,6 +7987,16 @@ func (m *TaskTypeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case tasktype.FieldType:
 		return m.GetType()
+	case tasktype.FieldTitle:
+		return m.Title()
+	case tasktype.FieldPrompt:
+		return m.Prompt()
+	case tasktype.FieldPetTypes:
+		return m.PetTypes()
+	case tasktype.FieldWeight:
+		return m.Weight()
+	case tasktype.FieldActive:
+		return m.Active()
 	case tasktype.FieldTextFeature:
 		return m.TextFeature()
 	}
@@ -3983,6 +8010,16 @@ func (m *TaskTypeMutation) OldField(ctx context.Context, name string) (ent.Value
 	switch name {
 	case tasktype.FieldType:
 		return m.OldType(ctx)
+	case tasktype.FieldTitle:
+		return m.OldTitle(ctx)
+	case tasktype.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case tasktype.FieldPetTypes:
+		return m.OldPetTypes(ctx)
+	case tasktype.FieldWeight:
+		return m.OldWeight(ctx)
+	case tasktype.FieldActive:
+		return m.OldActive(ctx)
 	case tasktype.FieldTextFeature:
 		return m.OldTextFeature(ctx)
 	}
@@ -4001,6 +8038,41 @@ func (m *TaskTypeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetType(v)
 		return nil
+	case tasktype.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case tasktype.FieldPrompt:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case tasktype.FieldPetTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPetTypes(v)
+		return nil
+	case tasktype.FieldWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case tasktype.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
 	case tasktype.FieldTextFeature:
 		v, ok := value.(pgvector.Vector)
 		if !ok {
@@ -4015,13 +8087,21 @@ func (m *TaskTypeMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *TaskTypeMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addweight != nil {
+		fields = append(fields, tasktype.FieldWeight)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *TaskTypeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tasktype.FieldWeight:
+		return m.AddedWeight()
+	}
 	return nil, false
 }
 
@@ -4030,6 +8110,13 @@ func (m *TaskTypeMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *TaskTypeMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tasktype.FieldWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
 	}
 	return fmt.Errorf("unknown TaskType numeric field %s", name)
 }
@@ -4038,6 +8125,12 @@ func (m *TaskTypeMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *TaskTypeMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(tasktype.FieldPrompt) {
+		fields = append(fields, tasktype.FieldPrompt)
+	}
+	if m.FieldCleared(tasktype.FieldPetTypes) {
+		fields = append(fields, tasktype.FieldPetTypes)
+	}
 	if m.FieldCleared(tasktype.FieldTextFeature) {
 		fields = append(fields, tasktype.FieldTextFeature)
 	}
@@ -4055,6 +8148,12 @@ func (m *TaskTypeMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *TaskTypeMutation) ClearField(name string) error {
 	switch name {
+	case tasktype.FieldPrompt:
+		m.ClearPrompt()
+		return nil
+	case tasktype.FieldPetTypes:
+		m.ClearPetTypes()
+		return nil
 	case tasktype.FieldTextFeature:
 		m.ClearTextFeature()
 		return nil
@@ -4069,6 +8168,21 @@ func (m *TaskTypeMutation) ResetField(name string) error {
 	case tasktype.FieldType:
 		m.ResetType()
 		return nil
+	case tasktype.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case tasktype.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case tasktype.FieldPetTypes:
+		m.ResetPetTypes()
+		return nil
+	case tasktype.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case tasktype.FieldActive:
+		m.ResetActive()
+		return nil
 	case tasktype.FieldTextFeature:
 		m.ResetTextFeature()
 		return nil
@@ -4127,41 +8241,50 @@ func (m *TaskTypeMutation) ResetEdge(name string) error {
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	index              *int
-	addindex           *int
-	email              *string
-	name               *string
-	bio                *string
-	icon_image_key     *string
-	created_at         *time.Time
-	clearedFields      map[string]struct{}
-	posts              map[uuid.UUID]struct{}
-	removedposts       map[uuid.UUID]struct{}
-	clearedposts       bool
-	comments           map[uuid.UUID]struct{}
-	removedcomments    map[uuid.UUID]struct{}
-	clearedcomments    bool
-	likes              map[uuid.UUID]struct{}
-	removedlikes       map[uuid.UUID]struct{}
-	clearedlikes       bool
-	pets               map[uuid.UUID]struct{}
-	removedpets        map[uuid.UUID]struct{}
-	clearedpets        bool
-	following          map[uuid.UUID]struct{}
-	removedfollowing   map[uuid.UUID]struct{}
-	clearedfollowing   bool
-	followers          map[uuid.UUID]struct{}
-	removedfollowers   map[uuid.UUID]struct{}
-	clearedfollowers   bool
-	daily_tasks        map[uuid.UUID]struct{}
-	removeddaily_tasks map[uuid.UUID]struct{}
-	cleareddaily_tasks bool
-	done               bool
-	oldValue           func(context.Context) (*User, error)
-	predicates         []predicate.User
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	index                *int
+	addindex             *int
+	email                *string
+	name                 *string
+	bio                  *string
+	icon_image_key       *string
+	is_admin             *bool
+	push_opt_outs        *[]string
+	appendpush_opt_outs  []string
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	posts                map[uuid.UUID]struct{}
+	removedposts         map[uuid.UUID]struct{}
+	clearedposts         bool
+	comments             map[uuid.UUID]struct{}
+	removedcomments      map[uuid.UUID]struct{}
+	clearedcomments      bool
+	likes                map[uuid.UUID]struct{}
+	removedlikes         map[uuid.UUID]struct{}
+	clearedlikes         bool
+	pets                 map[uuid.UUID]struct{}
+	removedpets          map[uuid.UUID]struct{}
+	clearedpets          bool
+	following            map[uuid.UUID]struct{}
+	removedfollowing     map[uuid.UUID]struct{}
+	clearedfollowing     bool
+	followers            map[uuid.UUID]struct{}
+	removedfollowers     map[uuid.UUID]struct{}
+	clearedfollowers     bool
+	daily_tasks          map[uuid.UUID]struct{}
+	removeddaily_tasks   map[uuid.UUID]struct{}
+	cleareddaily_tasks   bool
+	notifications        map[uuid.UUID]struct{}
+	removednotifications map[uuid.UUID]struct{}
+	clearednotifications bool
+	push_tokens          map[uuid.UUID]struct{}
+	removedpush_tokens   map[uuid.UUID]struct{}
+	clearedpush_tokens   bool
+	done                 bool
+	oldValue             func(context.Context) (*User, error)
+	predicates           []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -4495,6 +8618,107 @@ func (m *UserMutation) ResetIconImageKey() {
 	delete(m.clearedFields, user.FieldIconImageKey)
 }
 
+// SetIsAdmin sets the "is_admin" field.
+func (m *UserMutation) SetIsAdmin(b bool) {
+	m.is_admin = &b
+}
+
+// IsAdmin returns the value of the "is_admin" field in the mutation.
+func (m *UserMutation) IsAdmin() (r bool, exists bool) {
+	v := m.is_admin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAdmin returns the old "is_admin" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAdmin: %w", err)
+	}
+	return oldValue.IsAdmin, nil
+}
+
+// ResetIsAdmin resets all changes to the "is_admin" field.
+func (m *UserMutation) ResetIsAdmin() {
+	m.is_admin = nil
+}
+
+// SetPushOptOuts sets the "push_opt_outs" field.
+func (m *UserMutation) SetPushOptOuts(s []string) {
+	m.push_opt_outs = &s
+	m.appendpush_opt_outs = nil
+}
+
+// PushOptOuts returns the value of the "push_opt_outs" field in the mutation.
+func (m *UserMutation) PushOptOuts() (r []string, exists bool) {
+	v := m.push_opt_outs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushOptOuts returns the old "push_opt_outs" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPushOptOuts(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushOptOuts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushOptOuts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushOptOuts: %w", err)
+	}
+	return oldValue.PushOptOuts, nil
+}
+
+// AppendPushOptOuts adds s to the "push_opt_outs" field.
+func (m *UserMutation) AppendPushOptOuts(s []string) {
+	m.appendpush_opt_outs = append(m.appendpush_opt_outs, s...)
+}
+
+// AppendedPushOptOuts returns the list of values that were appended to the "push_opt_outs" field in this mutation.
+func (m *UserMutation) AppendedPushOptOuts() ([]string, bool) {
+	if len(m.appendpush_opt_outs) == 0 {
+		return nil, false
+	}
+	return m.appendpush_opt_outs, true
+}
+
+// ClearPushOptOuts clears the value of the "push_opt_outs" field.
+func (m *UserMutation) ClearPushOptOuts() {
+	m.push_opt_outs = nil
+	m.appendpush_opt_outs = nil
+	m.clearedFields[user.FieldPushOptOuts] = struct{}{}
+}
+
+// PushOptOutsCleared returns if the "push_opt_outs" field was cleared in this mutation.
+func (m *UserMutation) PushOptOutsCleared() bool {
+	_, ok := m.clearedFields[user.FieldPushOptOuts]
+	return ok
+}
+
+// ResetPushOptOuts resets all changes to the "push_opt_outs" field.
+func (m *UserMutation) ResetPushOptOuts() {
+	m.push_opt_outs = nil
+	m.appendpush_opt_outs = nil
+	delete(m.clearedFields, user.FieldPushOptOuts)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -4909,6 +9133,114 @@ func (m *UserMutation) ResetDailyTasks() {
 	m.removeddaily_tasks = nil
 }
 
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *UserMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *UserMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *UserMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// AddPushTokenIDs adds the "push_tokens" edge to the PushToken entity by ids.
+func (m *UserMutation) AddPushTokenIDs(ids ...uuid.UUID) {
+	if m.push_tokens == nil {
+		m.push_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.push_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPushTokens clears the "push_tokens" edge to the PushToken entity.
+func (m *UserMutation) ClearPushTokens() {
+	m.clearedpush_tokens = true
+}
+
+// PushTokensCleared reports if the "push_tokens" edge to the PushToken entity was cleared.
+func (m *UserMutation) PushTokensCleared() bool {
+	return m.clearedpush_tokens
+}
+
+// RemovePushTokenIDs removes the "push_tokens" edge to the PushToken entity by IDs.
+func (m *UserMutation) RemovePushTokenIDs(ids ...uuid.UUID) {
+	if m.removedpush_tokens == nil {
+		m.removedpush_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.push_tokens, ids[i])
+		m.removedpush_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPushTokens returns the removed IDs of the "push_tokens" edge to the PushToken entity.
+func (m *UserMutation) RemovedPushTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedpush_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PushTokensIDs returns the "push_tokens" edge IDs in the mutation.
+func (m *UserMutation) PushTokensIDs() (ids []uuid.UUID) {
+	for id := range m.push_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPushTokens resets all changes to the "push_tokens" edge.
+func (m *UserMutation) ResetPushTokens() {
+	m.push_tokens = nil
+	m.clearedpush_tokens = false
+	m.removedpush_tokens = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -4943,7 +9275,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.index != nil {
 		fields = append(fields, user.FieldIndex)
 	}
@@ -4959,6 +9291,12 @@ func (m *UserMutation) Fields() []string {
 	if m.icon_image_key != nil {
 		fields = append(fields, user.FieldIconImageKey)
 	}
+	if m.is_admin != nil {
+		fields = append(fields, user.FieldIsAdmin)
+	}
+	if m.push_opt_outs != nil {
+		fields = append(fields, user.FieldPushOptOuts)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -4980,6 +9318,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Bio()
 	case user.FieldIconImageKey:
 		return m.IconImageKey()
+	case user.FieldIsAdmin:
+		return m.IsAdmin()
+	case user.FieldPushOptOuts:
+		return m.PushOptOuts()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -5001,6 +9343,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldBio(ctx)
 	case user.FieldIconImageKey:
 		return m.OldIconImageKey(ctx)
+	case user.FieldIsAdmin:
+		return m.OldIsAdmin(ctx)
+	case user.FieldPushOptOuts:
+		return m.OldPushOptOuts(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -5047,6 +9393,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIconImageKey(v)
 		return nil
+	case user.FieldIsAdmin:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAdmin(v)
+		return nil
+	case user.FieldPushOptOuts:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushOptOuts(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -5105,6 +9465,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldIconImageKey) {
 		fields = append(fields, user.FieldIconImageKey)
 	}
+	if m.FieldCleared(user.FieldPushOptOuts) {
+		fields = append(fields, user.FieldPushOptOuts)
+	}
 	return fields
 }
 
@@ -5125,6 +9488,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldIconImageKey:
 		m.ClearIconImageKey()
 		return nil
+	case user.FieldPushOptOuts:
+		m.ClearPushOptOuts()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -5148,6 +9514,12 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldIconImageKey:
 		m.ResetIconImageKey()
 		return nil
+	case user.FieldIsAdmin:
+		m.ResetIsAdmin()
+		return nil
+	case user.FieldPushOptOuts:
+		m.ResetPushOptOuts()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -5157,7 +9529,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
+	edges := make([]string, 0, 9)
 	if m.posts != nil {
 		edges = append(edges, user.EdgePosts)
 	}
@@ -5179,6 +9551,12 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.daily_tasks != nil {
 		edges = append(edges, user.EdgeDailyTasks)
 	}
+	if m.notifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.push_tokens != nil {
+		edges = append(edges, user.EdgePushTokens)
+	}
 	return edges
 }
 
@@ -5228,13 +9606,25 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePushTokens:
+		ids := make([]ent.Value, 0, len(m.push_tokens))
+		for id := range m.push_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
+	edges := make([]string, 0, 9)
 	if m.removedposts != nil {
 		edges = append(edges, user.EdgePosts)
 	}
@@ -5256,6 +9646,12 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removeddaily_tasks != nil {
 		edges = append(edges, user.EdgeDailyTasks)
 	}
+	if m.removednotifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.removedpush_tokens != nil {
+		edges = append(edges, user.EdgePushTokens)
+	}
 	return edges
 }
 
@@ -5305,13 +9701,25 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePushTokens:
+		ids := make([]ent.Value, 0, len(m.removedpush_tokens))
+		for id := range m.removedpush_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
+	edges := make([]string, 0, 9)
 	if m.clearedposts {
 		edges = append(edges, user.EdgePosts)
 	}
@@ -5333,6 +9741,12 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.cleareddaily_tasks {
 		edges = append(edges, user.EdgeDailyTasks)
 	}
+	if m.clearednotifications {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.clearedpush_tokens {
+		edges = append(edges, user.EdgePushTokens)
+	}
 	return edges
 }
 
@@ -5354,6 +9768,10 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedfollowers
 	case user.EdgeDailyTasks:
 		return m.cleareddaily_tasks
+	case user.EdgeNotifications:
+		return m.clearednotifications
+	case user.EdgePushTokens:
+		return m.clearedpush_tokens
 	}
 	return false
 }
@@ -5391,6 +9809,12 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeDailyTasks:
 		m.ResetDailyTasks()
 		return nil
+	case user.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case user.EdgePushTokens:
+		m.ResetPushTokens()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
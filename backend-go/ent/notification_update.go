@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NotificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetUserNotifications sets the "user_notifications" field.
+func (nu *NotificationUpdate) SetUserNotifications(u uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetUserNotifications(u)
+	return nu
+}
+
+// SetNillableUserNotifications sets the "user_notifications" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableUserNotifications(u *uuid.UUID) *NotificationUpdate {
+	if u != nil {
+		nu.SetUserNotifications(*u)
+	}
+	return nu
+}
+
+// SetKind sets the "kind" field.
+func (nu *NotificationUpdate) SetKind(n notification.Kind) *NotificationUpdate {
+	nu.mutation.SetKind(n)
+	return nu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableKind(n *notification.Kind) *NotificationUpdate {
+	if n != nil {
+		nu.SetKind(*n)
+	}
+	return nu
+}
+
+// SetActorIds sets the "actor_ids" field.
+func (nu *NotificationUpdate) SetActorIds(u []uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetActorIds(u)
+	return nu
+}
+
+// AppendActorIds appends u to the "actor_ids" field.
+func (nu *NotificationUpdate) AppendActorIds(u []uuid.UUID) *NotificationUpdate {
+	nu.mutation.AppendActorIds(u)
+	return nu
+}
+
+// SetRead sets the "read" field.
+func (nu *NotificationUpdate) SetRead(b bool) *NotificationUpdate {
+	nu.mutation.SetRead(b)
+	return nu
+}
+
+// SetNillableRead sets the "read" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableRead(b *bool) *NotificationUpdate {
+	if b != nil {
+		nu.SetRead(*b)
+	}
+	return nu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nu *NotificationUpdate) SetCreatedAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetCreatedAt(t)
+	return nu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableCreatedAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetCreatedAt(*t)
+	}
+	return nu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nu *NotificationUpdate) SetUpdatedAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetUpdatedAt(t)
+	return nu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableUpdatedAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetUpdatedAt(*t)
+	}
+	return nu
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by ID.
+func (nu *NotificationUpdate) SetRecipientID(id uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetRecipientID(id)
+	return nu
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (nu *NotificationUpdate) SetRecipient(u *User) *NotificationUpdate {
+	return nu.SetRecipientID(u.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (nu *NotificationUpdate) SetActorID(id uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetActorID(id)
+	return nu
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (nu *NotificationUpdate) SetActor(u *User) *NotificationUpdate {
+	return nu.SetActorID(u.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (nu *NotificationUpdate) SetPostID(id uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetPostID(id)
+	return nu
+}
+
+// SetNillablePostID sets the "post" edge to the Post entity by ID if the given value is not nil.
+func (nu *NotificationUpdate) SetNillablePostID(id *uuid.UUID) *NotificationUpdate {
+	if id != nil {
+		nu = nu.SetPostID(*id)
+	}
+	return nu
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (nu *NotificationUpdate) SetPost(p *Post) *NotificationUpdate {
+	return nu.SetPostID(p.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (nu *NotificationUpdate) ClearRecipient() *NotificationUpdate {
+	nu.mutation.ClearRecipient()
+	return nu
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (nu *NotificationUpdate) ClearActor() *NotificationUpdate {
+	nu.mutation.ClearActor()
+	return nu
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (nu *NotificationUpdate) ClearPost() *NotificationUpdate {
+	nu.mutation.ClearPost()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if nu.mutation.RecipientCleared() && len(nu.mutation.RecipientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.recipient"`)
+	}
+	if nu.mutation.ActorCleared() && len(nu.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.actor"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (nu *NotificationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationUpdate {
+	nu.modifiers = append(nu.modifiers, modifiers...)
+	return nu
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.ActorIds(); ok {
+		_spec.SetField(notification.FieldActorIds, field.TypeJSON, value)
+	}
+	if value, ok := nu.mutation.AppendedActorIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notification.FieldActorIds, value)
+		})
+	}
+	if value, ok := nu.mutation.Read(); ok {
+		_spec.SetField(notification.FieldRead, field.TypeBool, value)
+	}
+	if value, ok := nu.mutation.CreatedAt(); ok {
+		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := nu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nu.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(nu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NotificationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserNotifications sets the "user_notifications" field.
+func (nuo *NotificationUpdateOne) SetUserNotifications(u uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetUserNotifications(u)
+	return nuo
+}
+
+// SetNillableUserNotifications sets the "user_notifications" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableUserNotifications(u *uuid.UUID) *NotificationUpdateOne {
+	if u != nil {
+		nuo.SetUserNotifications(*u)
+	}
+	return nuo
+}
+
+// SetKind sets the "kind" field.
+func (nuo *NotificationUpdateOne) SetKind(n notification.Kind) *NotificationUpdateOne {
+	nuo.mutation.SetKind(n)
+	return nuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableKind(n *notification.Kind) *NotificationUpdateOne {
+	if n != nil {
+		nuo.SetKind(*n)
+	}
+	return nuo
+}
+
+// SetActorIds sets the "actor_ids" field.
+func (nuo *NotificationUpdateOne) SetActorIds(u []uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetActorIds(u)
+	return nuo
+}
+
+// AppendActorIds appends u to the "actor_ids" field.
+func (nuo *NotificationUpdateOne) AppendActorIds(u []uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.AppendActorIds(u)
+	return nuo
+}
+
+// SetRead sets the "read" field.
+func (nuo *NotificationUpdateOne) SetRead(b bool) *NotificationUpdateOne {
+	nuo.mutation.SetRead(b)
+	return nuo
+}
+
+// SetNillableRead sets the "read" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableRead(b *bool) *NotificationUpdateOne {
+	if b != nil {
+		nuo.SetRead(*b)
+	}
+	return nuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nuo *NotificationUpdateOne) SetCreatedAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetCreatedAt(t)
+	return nuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableCreatedAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetCreatedAt(*t)
+	}
+	return nuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nuo *NotificationUpdateOne) SetUpdatedAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetUpdatedAt(t)
+	return nuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableUpdatedAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetUpdatedAt(*t)
+	}
+	return nuo
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by ID.
+func (nuo *NotificationUpdateOne) SetRecipientID(id uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetRecipientID(id)
+	return nuo
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetRecipient(u *User) *NotificationUpdateOne {
+	return nuo.SetRecipientID(u.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (nuo *NotificationUpdateOne) SetActorID(id uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetActorID(id)
+	return nuo
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetActor(u *User) *NotificationUpdateOne {
+	return nuo.SetActorID(u.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (nuo *NotificationUpdateOne) SetPostID(id uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetPostID(id)
+	return nuo
+}
+
+// SetNillablePostID sets the "post" edge to the Post entity by ID if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillablePostID(id *uuid.UUID) *NotificationUpdateOne {
+	if id != nil {
+		nuo = nuo.SetPostID(*id)
+	}
+	return nuo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (nuo *NotificationUpdateOne) SetPost(p *Post) *NotificationUpdateOne {
+	return nuo.SetPostID(p.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearRecipient() *NotificationUpdateOne {
+	nuo.mutation.ClearRecipient()
+	return nuo
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearActor() *NotificationUpdateOne {
+	nuo.mutation.ClearActor()
+	return nuo
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (nuo *NotificationUpdateOne) ClearPost() *NotificationUpdateOne {
+	nuo.mutation.ClearPost()
+	return nuo
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if nuo.mutation.RecipientCleared() && len(nuo.mutation.RecipientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.recipient"`)
+	}
+	if nuo.mutation.ActorCleared() && len(nuo.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.actor"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (nuo *NotificationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationUpdateOne {
+	nuo.modifiers = append(nuo.modifiers, modifiers...)
+	return nuo
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.ActorIds(); ok {
+		_spec.SetField(notification.FieldActorIds, field.TypeJSON, value)
+	}
+	if value, ok := nuo.mutation.AppendedActorIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notification.FieldActorIds, value)
+		})
+	}
+	if value, ok := nuo.mutation.Read(); ok {
+		_spec.SetField(notification.FieldRead, field.TypeBool, value)
+	}
+	if value, ok := nuo.mutation.CreatedAt(); ok {
+		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := nuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nuo.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(nuo.modifiers...)
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
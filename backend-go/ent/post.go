@@ -31,6 +31,12 @@ type Post struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// ImageDeletedAt holds the value of the "image_deleted_at" field.
+	ImageDeletedAt time.Time `json:"image_deleted_at,omitempty"`
+	// ImageReapAttempts holds the value of the "image_reap_attempts" field.
+	ImageReapAttempts int `json:"image_reap_attempts,omitempty"`
+	// TaskScore holds the value of the "task_score" field.
+	TaskScore *float64 `json:"task_score,omitempty"`
 	// ImageFeature holds the value of the "image_feature" field.
 	ImageFeature pgvector.Vector `json:"image_feature,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -50,9 +56,11 @@ type PostEdges struct {
 	Likes []*Like `json:"likes,omitempty"`
 	// DailyTask holds the value of the daily_task edge.
 	DailyTask *DailyTask `json:"daily_task,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -95,6 +103,15 @@ func (e PostEdges) DailyTaskOrErr() (*DailyTask, error) {
 	return nil, &NotLoadedError{edge: "daily_task"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[4] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -102,11 +119,13 @@ func (*Post) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case post.FieldImageFeature:
 			values[i] = new(pgvector.Vector)
-		case post.FieldIndex:
+		case post.FieldTaskScore:
+			values[i] = new(sql.NullFloat64)
+		case post.FieldIndex, post.FieldImageReapAttempts:
 			values[i] = new(sql.NullInt64)
 		case post.FieldCaption, post.FieldImageKey:
 			values[i] = new(sql.NullString)
-		case post.FieldCreatedAt, post.FieldDeletedAt:
+		case post.FieldCreatedAt, post.FieldDeletedAt, post.FieldImageDeletedAt:
 			values[i] = new(sql.NullTime)
 		case post.FieldID:
 			values[i] = new(uuid.UUID)
@@ -163,6 +182,25 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.DeletedAt = value.Time
 			}
+		case post.FieldImageDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field image_deleted_at", values[i])
+			} else if value.Valid {
+				po.ImageDeletedAt = value.Time
+			}
+		case post.FieldImageReapAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field image_reap_attempts", values[i])
+			} else if value.Valid {
+				po.ImageReapAttempts = int(value.Int64)
+			}
+		case post.FieldTaskScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_score", values[i])
+			} else if value.Valid {
+				po.TaskScore = new(float64)
+				*po.TaskScore = value.Float64
+			}
 		case post.FieldImageFeature:
 			if value, ok := values[i].(*pgvector.Vector); !ok {
 				return fmt.Errorf("unexpected type %T for field image_feature", values[i])
@@ -209,6 +247,11 @@ func (po *Post) QueryDailyTask() *DailyTaskQuery {
 	return NewPostClient(po.config).QueryDailyTask(po)
 }
 
+// QueryTags queries the "tags" edge of the Post entity.
+func (po *Post) QueryTags() *TagQuery {
+	return NewPostClient(po.config).QueryTags(po)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -247,6 +290,17 @@ func (po *Post) String() string {
 	builder.WriteString("deleted_at=")
 	builder.WriteString(po.DeletedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("image_deleted_at=")
+	builder.WriteString(po.ImageDeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("image_reap_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", po.ImageReapAttempts))
+	builder.WriteString(", ")
+	if v := po.TaskScore; v != nil {
+		builder.WriteString("task_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("image_feature=")
 	builder.WriteString(fmt.Sprintf("%v", po.ImageFeature))
 	builder.WriteByte(')')
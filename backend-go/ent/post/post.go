@@ -25,6 +25,12 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldImageDeletedAt holds the string denoting the image_deleted_at field in the database.
+	FieldImageDeletedAt = "image_deleted_at"
+	// FieldImageReapAttempts holds the string denoting the image_reap_attempts field in the database.
+	FieldImageReapAttempts = "image_reap_attempts"
+	// FieldTaskScore holds the string denoting the task_score field in the database.
+	FieldTaskScore = "task_score"
 	// FieldImageFeature holds the string denoting the image_feature field in the database.
 	FieldImageFeature = "image_feature"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -35,6 +41,8 @@ const (
 	EdgeLikes = "likes"
 	// EdgeDailyTask holds the string denoting the daily_task edge name in mutations.
 	EdgeDailyTask = "daily_task"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the post in the database.
 	Table = "posts"
 	// UserTable is the table that holds the user relation/edge.
@@ -65,6 +73,11 @@ const (
 	DailyTaskInverseTable = "daily_tasks"
 	// DailyTaskColumn is the table column denoting the daily_task relation/edge.
 	DailyTaskColumn = "post_daily_task"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_posts"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for post fields.
@@ -75,6 +88,9 @@ var Columns = []string{
 	FieldImageKey,
 	FieldCreatedAt,
 	FieldDeletedAt,
+	FieldImageDeletedAt,
+	FieldImageReapAttempts,
+	FieldTaskScore,
 	FieldImageFeature,
 }
 
@@ -84,6 +100,12 @@ var ForeignKeys = []string{
 	"user_posts",
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "post_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -108,6 +130,10 @@ var (
 	ImageKeyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultImageReapAttempts holds the default value on creation for the "image_reap_attempts" field.
+	DefaultImageReapAttempts int
+	// ImageReapAttemptsValidator is a validator for the "image_reap_attempts" field. It is called by the builders before save.
+	ImageReapAttemptsValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -145,6 +171,21 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByImageDeletedAt orders the results by the image_deleted_at field.
+func ByImageDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageDeletedAt, opts...).ToFunc()
+}
+
+// ByImageReapAttempts orders the results by the image_reap_attempts field.
+func ByImageReapAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageReapAttempts, opts...).ToFunc()
+}
+
+// ByTaskScore orders the results by the task_score field.
+func ByTaskScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskScore, opts...).ToFunc()
+}
+
 // ByImageFeature orders the results by the image_feature field.
 func ByImageFeature(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImageFeature, opts...).ToFunc()
@@ -191,6 +232,20 @@ func ByDailyTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDailyTaskStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -219,3 +274,10 @@ func newDailyTaskStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, DailyTaskTable, DailyTaskColumn),
 	)
 }
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
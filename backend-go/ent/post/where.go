@@ -82,6 +82,21 @@ func DeletedAt(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// ImageDeletedAt applies equality check predicate on the "image_deleted_at" field. It's identical to ImageDeletedAtEQ.
+func ImageDeletedAt(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldImageDeletedAt, v))
+}
+
+// ImageReapAttempts applies equality check predicate on the "image_reap_attempts" field. It's identical to ImageReapAttemptsEQ.
+func ImageReapAttempts(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldImageReapAttempts, v))
+}
+
+// TaskScore applies equality check predicate on the "task_score" field. It's identical to TaskScoreEQ.
+func TaskScore(v float64) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldTaskScore, v))
+}
+
 // ImageFeature applies equality check predicate on the "image_feature" field. It's identical to ImageFeatureEQ.
 func ImageFeature(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldImageFeature, v))
@@ -357,6 +372,146 @@ func DeletedAtNotNil() predicate.Post {
 	return predicate.Post(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// ImageDeletedAtEQ applies the EQ predicate on the "image_deleted_at" field.
+func ImageDeletedAtEQ(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldImageDeletedAt, v))
+}
+
+// ImageDeletedAtNEQ applies the NEQ predicate on the "image_deleted_at" field.
+func ImageDeletedAtNEQ(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldImageDeletedAt, v))
+}
+
+// ImageDeletedAtIn applies the In predicate on the "image_deleted_at" field.
+func ImageDeletedAtIn(vs ...time.Time) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldImageDeletedAt, vs...))
+}
+
+// ImageDeletedAtNotIn applies the NotIn predicate on the "image_deleted_at" field.
+func ImageDeletedAtNotIn(vs ...time.Time) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldImageDeletedAt, vs...))
+}
+
+// ImageDeletedAtGT applies the GT predicate on the "image_deleted_at" field.
+func ImageDeletedAtGT(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldImageDeletedAt, v))
+}
+
+// ImageDeletedAtGTE applies the GTE predicate on the "image_deleted_at" field.
+func ImageDeletedAtGTE(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldImageDeletedAt, v))
+}
+
+// ImageDeletedAtLT applies the LT predicate on the "image_deleted_at" field.
+func ImageDeletedAtLT(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldImageDeletedAt, v))
+}
+
+// ImageDeletedAtLTE applies the LTE predicate on the "image_deleted_at" field.
+func ImageDeletedAtLTE(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldImageDeletedAt, v))
+}
+
+// ImageDeletedAtIsNil applies the IsNil predicate on the "image_deleted_at" field.
+func ImageDeletedAtIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldImageDeletedAt))
+}
+
+// ImageDeletedAtNotNil applies the NotNil predicate on the "image_deleted_at" field.
+func ImageDeletedAtNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldImageDeletedAt))
+}
+
+// ImageReapAttemptsEQ applies the EQ predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldImageReapAttempts, v))
+}
+
+// ImageReapAttemptsNEQ applies the NEQ predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsNEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldImageReapAttempts, v))
+}
+
+// ImageReapAttemptsIn applies the In predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldImageReapAttempts, vs...))
+}
+
+// ImageReapAttemptsNotIn applies the NotIn predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsNotIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldImageReapAttempts, vs...))
+}
+
+// ImageReapAttemptsGT applies the GT predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsGT(v int) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldImageReapAttempts, v))
+}
+
+// ImageReapAttemptsGTE applies the GTE predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsGTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldImageReapAttempts, v))
+}
+
+// ImageReapAttemptsLT applies the LT predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsLT(v int) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldImageReapAttempts, v))
+}
+
+// ImageReapAttemptsLTE applies the LTE predicate on the "image_reap_attempts" field.
+func ImageReapAttemptsLTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldImageReapAttempts, v))
+}
+
+// TaskScoreEQ applies the EQ predicate on the "task_score" field.
+func TaskScoreEQ(v float64) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldTaskScore, v))
+}
+
+// TaskScoreNEQ applies the NEQ predicate on the "task_score" field.
+func TaskScoreNEQ(v float64) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldTaskScore, v))
+}
+
+// TaskScoreIn applies the In predicate on the "task_score" field.
+func TaskScoreIn(vs ...float64) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldTaskScore, vs...))
+}
+
+// TaskScoreNotIn applies the NotIn predicate on the "task_score" field.
+func TaskScoreNotIn(vs ...float64) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldTaskScore, vs...))
+}
+
+// TaskScoreGT applies the GT predicate on the "task_score" field.
+func TaskScoreGT(v float64) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldTaskScore, v))
+}
+
+// TaskScoreGTE applies the GTE predicate on the "task_score" field.
+func TaskScoreGTE(v float64) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldTaskScore, v))
+}
+
+// TaskScoreLT applies the LT predicate on the "task_score" field.
+func TaskScoreLT(v float64) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldTaskScore, v))
+}
+
+// TaskScoreLTE applies the LTE predicate on the "task_score" field.
+func TaskScoreLTE(v float64) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldTaskScore, v))
+}
+
+// TaskScoreIsNil applies the IsNil predicate on the "task_score" field.
+func TaskScoreIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldTaskScore))
+}
+
+// TaskScoreNotNil applies the NotNil predicate on the "task_score" field.
+func TaskScoreNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldTaskScore))
+}
+
 // ImageFeatureEQ applies the EQ predicate on the "image_feature" field.
 func ImageFeatureEQ(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldImageFeature, v))
@@ -499,6 +654,29 @@ func HasDailyTaskWith(preds ...predicate.DailyTask) predicate.Post {
 	})
 }
 
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Post) predicate.Post {
 	return predicate.Post(sql.AndPredicates(predicates...))
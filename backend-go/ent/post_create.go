@@ -16,6 +16,7 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
@@ -83,6 +84,48 @@ func (pc *PostCreate) SetNillableDeletedAt(t *time.Time) *PostCreate {
 	return pc
 }
 
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (pc *PostCreate) SetImageDeletedAt(t time.Time) *PostCreate {
+	pc.mutation.SetImageDeletedAt(t)
+	return pc
+}
+
+// SetNillableImageDeletedAt sets the "image_deleted_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableImageDeletedAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetImageDeletedAt(*t)
+	}
+	return pc
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (pc *PostCreate) SetImageReapAttempts(i int) *PostCreate {
+	pc.mutation.SetImageReapAttempts(i)
+	return pc
+}
+
+// SetNillableImageReapAttempts sets the "image_reap_attempts" field if the given value is not nil.
+func (pc *PostCreate) SetNillableImageReapAttempts(i *int) *PostCreate {
+	if i != nil {
+		pc.SetImageReapAttempts(*i)
+	}
+	return pc
+}
+
+// SetTaskScore sets the "task_score" field.
+func (pc *PostCreate) SetTaskScore(f float64) *PostCreate {
+	pc.mutation.SetTaskScore(f)
+	return pc
+}
+
+// SetNillableTaskScore sets the "task_score" field if the given value is not nil.
+func (pc *PostCreate) SetNillableTaskScore(f *float64) *PostCreate {
+	if f != nil {
+		pc.SetTaskScore(*f)
+	}
+	return pc
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (pc *PostCreate) SetImageFeature(pg pgvector.Vector) *PostCreate {
 	pc.mutation.SetImageFeature(pg)
@@ -171,6 +214,21 @@ func (pc *PostCreate) SetDailyTask(d *DailyTask) *PostCreate {
 	return pc.SetDailyTaskID(d.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (pc *PostCreate) AddTagIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddTagIDs(ids...)
+	return pc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (pc *PostCreate) AddTags(t ...*Tag) *PostCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTagIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pc *PostCreate) Mutation() *PostMutation {
 	return pc.mutation
@@ -210,6 +268,10 @@ func (pc *PostCreate) defaults() {
 		v := post.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := pc.mutation.ImageReapAttempts(); !ok {
+		v := post.DefaultImageReapAttempts
+		pc.mutation.SetImageReapAttempts(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		v := post.DefaultID()
 		pc.mutation.SetID(v)
@@ -242,6 +304,14 @@ func (pc *PostCreate) check() error {
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
+	if _, ok := pc.mutation.ImageReapAttempts(); !ok {
+		return &ValidationError{Name: "image_reap_attempts", err: errors.New(`ent: missing required field "Post.image_reap_attempts"`)}
+	}
+	if v, ok := pc.mutation.ImageReapAttempts(); ok {
+		if err := post.ImageReapAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "image_reap_attempts", err: fmt.Errorf(`ent: validator failed for field "Post.image_reap_attempts": %w`, err)}
+		}
+	}
 	if len(pc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Post.user"`)}
 	}
@@ -301,6 +371,18 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := pc.mutation.ImageDeletedAt(); ok {
+		_spec.SetField(post.FieldImageDeletedAt, field.TypeTime, value)
+		_node.ImageDeletedAt = value
+	}
+	if value, ok := pc.mutation.ImageReapAttempts(); ok {
+		_spec.SetField(post.FieldImageReapAttempts, field.TypeInt, value)
+		_node.ImageReapAttempts = value
+	}
+	if value, ok := pc.mutation.TaskScore(); ok {
+		_spec.SetField(post.FieldTaskScore, field.TypeFloat64, value)
+		_node.TaskScore = &value
+	}
 	if value, ok := pc.mutation.ImageFeature(); ok {
 		_spec.SetField(post.FieldImageFeature, field.TypeOther, value)
 		_node.ImageFeature = value
@@ -370,6 +452,22 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -476,6 +574,66 @@ func (u *PostUpsert) ClearDeletedAt() *PostUpsert {
 	return u
 }
 
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (u *PostUpsert) SetImageDeletedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldImageDeletedAt, v)
+	return u
+}
+
+// UpdateImageDeletedAt sets the "image_deleted_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateImageDeletedAt() *PostUpsert {
+	u.SetExcluded(post.FieldImageDeletedAt)
+	return u
+}
+
+// ClearImageDeletedAt clears the value of the "image_deleted_at" field.
+func (u *PostUpsert) ClearImageDeletedAt() *PostUpsert {
+	u.SetNull(post.FieldImageDeletedAt)
+	return u
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (u *PostUpsert) SetImageReapAttempts(v int) *PostUpsert {
+	u.Set(post.FieldImageReapAttempts, v)
+	return u
+}
+
+// UpdateImageReapAttempts sets the "image_reap_attempts" field to the value that was provided on create.
+func (u *PostUpsert) UpdateImageReapAttempts() *PostUpsert {
+	u.SetExcluded(post.FieldImageReapAttempts)
+	return u
+}
+
+// AddImageReapAttempts adds v to the "image_reap_attempts" field.
+func (u *PostUpsert) AddImageReapAttempts(v int) *PostUpsert {
+	u.Add(post.FieldImageReapAttempts, v)
+	return u
+}
+
+// SetTaskScore sets the "task_score" field.
+func (u *PostUpsert) SetTaskScore(v float64) *PostUpsert {
+	u.Set(post.FieldTaskScore, v)
+	return u
+}
+
+// UpdateTaskScore sets the "task_score" field to the value that was provided on create.
+func (u *PostUpsert) UpdateTaskScore() *PostUpsert {
+	u.SetExcluded(post.FieldTaskScore)
+	return u
+}
+
+// AddTaskScore adds v to the "task_score" field.
+func (u *PostUpsert) AddTaskScore(v float64) *PostUpsert {
+	u.Add(post.FieldTaskScore, v)
+	return u
+}
+
+// ClearTaskScore clears the value of the "task_score" field.
+func (u *PostUpsert) ClearTaskScore() *PostUpsert {
+	u.SetNull(post.FieldTaskScore)
+	return u
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (u *PostUpsert) SetImageFeature(v pgvector.Vector) *PostUpsert {
 	u.Set(post.FieldImageFeature, v)
@@ -608,6 +766,76 @@ func (u *PostUpsertOne) ClearDeletedAt() *PostUpsertOne {
 	})
 }
 
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (u *PostUpsertOne) SetImageDeletedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetImageDeletedAt(v)
+	})
+}
+
+// UpdateImageDeletedAt sets the "image_deleted_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateImageDeletedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateImageDeletedAt()
+	})
+}
+
+// ClearImageDeletedAt clears the value of the "image_deleted_at" field.
+func (u *PostUpsertOne) ClearImageDeletedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearImageDeletedAt()
+	})
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (u *PostUpsertOne) SetImageReapAttempts(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetImageReapAttempts(v)
+	})
+}
+
+// AddImageReapAttempts adds v to the "image_reap_attempts" field.
+func (u *PostUpsertOne) AddImageReapAttempts(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddImageReapAttempts(v)
+	})
+}
+
+// UpdateImageReapAttempts sets the "image_reap_attempts" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateImageReapAttempts() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateImageReapAttempts()
+	})
+}
+
+// SetTaskScore sets the "task_score" field.
+func (u *PostUpsertOne) SetTaskScore(v float64) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetTaskScore(v)
+	})
+}
+
+// AddTaskScore adds v to the "task_score" field.
+func (u *PostUpsertOne) AddTaskScore(v float64) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddTaskScore(v)
+	})
+}
+
+// UpdateTaskScore sets the "task_score" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateTaskScore() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateTaskScore()
+	})
+}
+
+// ClearTaskScore clears the value of the "task_score" field.
+func (u *PostUpsertOne) ClearTaskScore() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearTaskScore()
+	})
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (u *PostUpsertOne) SetImageFeature(v pgvector.Vector) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -910,6 +1138,76 @@ func (u *PostUpsertBulk) ClearDeletedAt() *PostUpsertBulk {
 	})
 }
 
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (u *PostUpsertBulk) SetImageDeletedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetImageDeletedAt(v)
+	})
+}
+
+// UpdateImageDeletedAt sets the "image_deleted_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateImageDeletedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateImageDeletedAt()
+	})
+}
+
+// ClearImageDeletedAt clears the value of the "image_deleted_at" field.
+func (u *PostUpsertBulk) ClearImageDeletedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearImageDeletedAt()
+	})
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (u *PostUpsertBulk) SetImageReapAttempts(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetImageReapAttempts(v)
+	})
+}
+
+// AddImageReapAttempts adds v to the "image_reap_attempts" field.
+func (u *PostUpsertBulk) AddImageReapAttempts(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddImageReapAttempts(v)
+	})
+}
+
+// UpdateImageReapAttempts sets the "image_reap_attempts" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateImageReapAttempts() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateImageReapAttempts()
+	})
+}
+
+// SetTaskScore sets the "task_score" field.
+func (u *PostUpsertBulk) SetTaskScore(v float64) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetTaskScore(v)
+	})
+}
+
+// AddTaskScore adds v to the "task_score" field.
+func (u *PostUpsertBulk) AddTaskScore(v float64) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddTaskScore(v)
+	})
+}
+
+// UpdateTaskScore sets the "task_score" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateTaskScore() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateTaskScore()
+	})
+}
+
+// ClearTaskScore clears the value of the "task_score" field.
+func (u *PostUpsertBulk) ClearTaskScore() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearTaskScore()
+	})
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (u *PostUpsertBulk) SetImageFeature(v pgvector.Vector) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
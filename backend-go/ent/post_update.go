@@ -16,6 +16,7 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
@@ -24,8 +25,9 @@ import (
 // PostUpdate is the builder for updating Post entities.
 type PostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PostMutation
+	hooks     []Hook
+	mutation  *PostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PostUpdate builder.
@@ -96,6 +98,74 @@ func (pu *PostUpdate) ClearDeletedAt() *PostUpdate {
 	return pu
 }
 
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (pu *PostUpdate) SetImageDeletedAt(t time.Time) *PostUpdate {
+	pu.mutation.SetImageDeletedAt(t)
+	return pu
+}
+
+// SetNillableImageDeletedAt sets the "image_deleted_at" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableImageDeletedAt(t *time.Time) *PostUpdate {
+	if t != nil {
+		pu.SetImageDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearImageDeletedAt clears the value of the "image_deleted_at" field.
+func (pu *PostUpdate) ClearImageDeletedAt() *PostUpdate {
+	pu.mutation.ClearImageDeletedAt()
+	return pu
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (pu *PostUpdate) SetImageReapAttempts(i int) *PostUpdate {
+	pu.mutation.ResetImageReapAttempts()
+	pu.mutation.SetImageReapAttempts(i)
+	return pu
+}
+
+// SetNillableImageReapAttempts sets the "image_reap_attempts" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableImageReapAttempts(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetImageReapAttempts(*i)
+	}
+	return pu
+}
+
+// AddImageReapAttempts adds i to the "image_reap_attempts" field.
+func (pu *PostUpdate) AddImageReapAttempts(i int) *PostUpdate {
+	pu.mutation.AddImageReapAttempts(i)
+	return pu
+}
+
+// SetTaskScore sets the "task_score" field.
+func (pu *PostUpdate) SetTaskScore(f float64) *PostUpdate {
+	pu.mutation.ResetTaskScore()
+	pu.mutation.SetTaskScore(f)
+	return pu
+}
+
+// SetNillableTaskScore sets the "task_score" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableTaskScore(f *float64) *PostUpdate {
+	if f != nil {
+		pu.SetTaskScore(*f)
+	}
+	return pu
+}
+
+// AddTaskScore adds f to the "task_score" field.
+func (pu *PostUpdate) AddTaskScore(f float64) *PostUpdate {
+	pu.mutation.AddTaskScore(f)
+	return pu
+}
+
+// ClearTaskScore clears the value of the "task_score" field.
+func (pu *PostUpdate) ClearTaskScore() *PostUpdate {
+	pu.mutation.ClearTaskScore()
+	return pu
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (pu *PostUpdate) SetImageFeature(pg pgvector.Vector) *PostUpdate {
 	pu.mutation.SetImageFeature(pg)
@@ -176,6 +246,21 @@ func (pu *PostUpdate) SetDailyTask(d *DailyTask) *PostUpdate {
 	return pu.SetDailyTaskID(d.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (pu *PostUpdate) AddTagIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddTagIDs(ids...)
+	return pu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (pu *PostUpdate) AddTags(t ...*Tag) *PostUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTagIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pu *PostUpdate) Mutation() *PostMutation {
 	return pu.mutation
@@ -235,6 +320,27 @@ func (pu *PostUpdate) ClearDailyTask() *PostUpdate {
 	return pu
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (pu *PostUpdate) ClearTags() *PostUpdate {
+	pu.mutation.ClearTags()
+	return pu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (pu *PostUpdate) RemoveTagIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveTagIDs(ids...)
+	return pu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (pu *PostUpdate) RemoveTags(t ...*Tag) *PostUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -274,12 +380,23 @@ func (pu *PostUpdate) check() error {
 			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "Post.image_key": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.ImageReapAttempts(); ok {
+		if err := post.ImageReapAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "image_reap_attempts", err: fmt.Errorf(`ent: validator failed for field "Post.image_reap_attempts": %w`, err)}
+		}
+	}
 	if pu.mutation.UserCleared() && len(pu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Post.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
 func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := pu.check(); err != nil {
 		return n, err
@@ -310,6 +427,27 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.DeletedAtCleared() {
 		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := pu.mutation.ImageDeletedAt(); ok {
+		_spec.SetField(post.FieldImageDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.ImageDeletedAtCleared() {
+		_spec.ClearField(post.FieldImageDeletedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.ImageReapAttempts(); ok {
+		_spec.SetField(post.FieldImageReapAttempts, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedImageReapAttempts(); ok {
+		_spec.AddField(post.FieldImageReapAttempts, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.TaskScore(); ok {
+		_spec.SetField(post.FieldTaskScore, field.TypeFloat64, value)
+	}
+	if value, ok := pu.mutation.AddedTaskScore(); ok {
+		_spec.AddField(post.FieldTaskScore, field.TypeFloat64, value)
+	}
+	if pu.mutation.TaskScoreCleared() {
+		_spec.ClearField(post.FieldTaskScore, field.TypeFloat64)
+	}
 	if value, ok := pu.mutation.ImageFeature(); ok {
 		_spec.SetField(post.FieldImageFeature, field.TypeOther, value)
 	}
@@ -464,6 +602,52 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !pu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
@@ -479,9 +663,10 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PostUpdateOne is the builder for updating a single Post entity.
 type PostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCaption sets the "caption" field.
@@ -546,6 +731,74 @@ func (puo *PostUpdateOne) ClearDeletedAt() *PostUpdateOne {
 	return puo
 }
 
+// SetImageDeletedAt sets the "image_deleted_at" field.
+func (puo *PostUpdateOne) SetImageDeletedAt(t time.Time) *PostUpdateOne {
+	puo.mutation.SetImageDeletedAt(t)
+	return puo
+}
+
+// SetNillableImageDeletedAt sets the "image_deleted_at" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableImageDeletedAt(t *time.Time) *PostUpdateOne {
+	if t != nil {
+		puo.SetImageDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearImageDeletedAt clears the value of the "image_deleted_at" field.
+func (puo *PostUpdateOne) ClearImageDeletedAt() *PostUpdateOne {
+	puo.mutation.ClearImageDeletedAt()
+	return puo
+}
+
+// SetImageReapAttempts sets the "image_reap_attempts" field.
+func (puo *PostUpdateOne) SetImageReapAttempts(i int) *PostUpdateOne {
+	puo.mutation.ResetImageReapAttempts()
+	puo.mutation.SetImageReapAttempts(i)
+	return puo
+}
+
+// SetNillableImageReapAttempts sets the "image_reap_attempts" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableImageReapAttempts(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetImageReapAttempts(*i)
+	}
+	return puo
+}
+
+// AddImageReapAttempts adds i to the "image_reap_attempts" field.
+func (puo *PostUpdateOne) AddImageReapAttempts(i int) *PostUpdateOne {
+	puo.mutation.AddImageReapAttempts(i)
+	return puo
+}
+
+// SetTaskScore sets the "task_score" field.
+func (puo *PostUpdateOne) SetTaskScore(f float64) *PostUpdateOne {
+	puo.mutation.ResetTaskScore()
+	puo.mutation.SetTaskScore(f)
+	return puo
+}
+
+// SetNillableTaskScore sets the "task_score" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableTaskScore(f *float64) *PostUpdateOne {
+	if f != nil {
+		puo.SetTaskScore(*f)
+	}
+	return puo
+}
+
+// AddTaskScore adds f to the "task_score" field.
+func (puo *PostUpdateOne) AddTaskScore(f float64) *PostUpdateOne {
+	puo.mutation.AddTaskScore(f)
+	return puo
+}
+
+// ClearTaskScore clears the value of the "task_score" field.
+func (puo *PostUpdateOne) ClearTaskScore() *PostUpdateOne {
+	puo.mutation.ClearTaskScore()
+	return puo
+}
+
 // SetImageFeature sets the "image_feature" field.
 func (puo *PostUpdateOne) SetImageFeature(pg pgvector.Vector) *PostUpdateOne {
 	puo.mutation.SetImageFeature(pg)
@@ -626,6 +879,21 @@ func (puo *PostUpdateOne) SetDailyTask(d *DailyTask) *PostUpdateOne {
 	return puo.SetDailyTaskID(d.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (puo *PostUpdateOne) AddTagIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddTagIDs(ids...)
+	return puo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (puo *PostUpdateOne) AddTags(t ...*Tag) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTagIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (puo *PostUpdateOne) Mutation() *PostMutation {
 	return puo.mutation
@@ -685,6 +953,27 @@ func (puo *PostUpdateOne) ClearDailyTask() *PostUpdateOne {
 	return puo
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (puo *PostUpdateOne) ClearTags() *PostUpdateOne {
+	puo.mutation.ClearTags()
+	return puo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (puo *PostUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveTagIDs(ids...)
+	return puo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (puo *PostUpdateOne) RemoveTags(t ...*Tag) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	puo.mutation.Where(ps...)
@@ -737,12 +1026,23 @@ func (puo *PostUpdateOne) check() error {
 			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "Post.image_key": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.ImageReapAttempts(); ok {
+		if err := post.ImageReapAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "image_reap_attempts", err: fmt.Errorf(`ent: validator failed for field "Post.image_reap_attempts": %w`, err)}
+		}
+	}
 	if puo.mutation.UserCleared() && len(puo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Post.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
 func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if err := puo.check(); err != nil {
 		return _node, err
@@ -790,6 +1090,27 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if puo.mutation.DeletedAtCleared() {
 		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := puo.mutation.ImageDeletedAt(); ok {
+		_spec.SetField(post.FieldImageDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.ImageDeletedAtCleared() {
+		_spec.ClearField(post.FieldImageDeletedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.ImageReapAttempts(); ok {
+		_spec.SetField(post.FieldImageReapAttempts, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedImageReapAttempts(); ok {
+		_spec.AddField(post.FieldImageReapAttempts, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.TaskScore(); ok {
+		_spec.SetField(post.FieldTaskScore, field.TypeFloat64, value)
+	}
+	if value, ok := puo.mutation.AddedTaskScore(); ok {
+		_spec.AddField(post.FieldTaskScore, field.TypeFloat64, value)
+	}
+	if puo.mutation.TaskScoreCleared() {
+		_spec.ClearField(post.FieldTaskScore, field.TypeFloat64)
+	}
 	if value, ok := puo.mutation.ImageFeature(); ok {
 		_spec.SetField(post.FieldImageFeature, field.TypeOther, value)
 	}
@@ -944,6 +1265,52 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !puo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(puo.modifiers...)
 	_node = &Post{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
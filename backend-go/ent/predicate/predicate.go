@@ -15,15 +15,30 @@ type DailyTask func(*sql.Selector)
 // FollowRelation is the predicate function for followrelation builders.
 type FollowRelation func(*sql.Selector)
 
+// IndexCounter is the predicate function for indexcounter builders.
+type IndexCounter func(*sql.Selector)
+
 // Like is the predicate function for like builders.
 type Like func(*sql.Selector)
 
+// LocalAccount is the predicate function for localaccount builders.
+type LocalAccount func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
 // Pet is the predicate function for pet builders.
 type Pet func(*sql.Selector)
 
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// PushToken is the predicate function for pushtoken builders.
+type PushToken func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // TaskType is the predicate function for tasktype builders.
 type TaskType func(*sql.Selector)
 
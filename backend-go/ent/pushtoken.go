@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// PushToken is the model entity for the PushToken schema.
+type PushToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Platform holds the value of the "platform" field.
+	Platform string `json:"platform,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PushTokenQuery when eager-loading is set.
+	Edges            PushTokenEdges `json:"edges"`
+	user_push_tokens *uuid.UUID
+	selectValues     sql.SelectValues
+}
+
+// PushTokenEdges holds the relations/edges for other nodes in the graph.
+type PushTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PushTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PushToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pushtoken.FieldToken, pushtoken.FieldPlatform:
+			values[i] = new(sql.NullString)
+		case pushtoken.FieldCreatedAt, pushtoken.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pushtoken.FieldID:
+			values[i] = new(uuid.UUID)
+		case pushtoken.ForeignKeys[0]: // user_push_tokens
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PushToken fields.
+func (pt *PushToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pushtoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pt.ID = *value
+			}
+		case pushtoken.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				pt.Token = value.String
+			}
+		case pushtoken.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				pt.Platform = value.String
+			}
+		case pushtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pt.CreatedAt = value.Time
+			}
+		case pushtoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pt.UpdatedAt = value.Time
+			}
+		case pushtoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_push_tokens", values[i])
+			} else if value.Valid {
+				pt.user_push_tokens = new(uuid.UUID)
+				*pt.user_push_tokens = *value.S.(*uuid.UUID)
+			}
+		default:
+			pt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PushToken.
+// This includes values selected through modifiers, order, etc.
+func (pt *PushToken) Value(name string) (ent.Value, error) {
+	return pt.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PushToken entity.
+func (pt *PushToken) QueryUser() *UserQuery {
+	return NewPushTokenClient(pt.config).QueryUser(pt)
+}
+
+// Update returns a builder for updating this PushToken.
+// Note that you need to call PushToken.Unwrap() before calling this method if this PushToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pt *PushToken) Update() *PushTokenUpdateOne {
+	return NewPushTokenClient(pt.config).UpdateOne(pt)
+}
+
+// Unwrap unwraps the PushToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pt *PushToken) Unwrap() *PushToken {
+	_tx, ok := pt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PushToken is not a transactional entity")
+	}
+	pt.config.driver = _tx.drv
+	return pt
+}
+
+// String implements the fmt.Stringer.
+func (pt *PushToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("PushToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pt.ID))
+	builder.WriteString("token=")
+	builder.WriteString(pt.Token)
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(pt.Platform)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PushTokens is a parsable slice of PushToken.
+type PushTokens []*PushToken
@@ -0,0 +1,741 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// PushTokenCreate is the builder for creating a PushToken entity.
+type PushTokenCreate struct {
+	config
+	mutation *PushTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetToken sets the "token" field.
+func (ptc *PushTokenCreate) SetToken(s string) *PushTokenCreate {
+	ptc.mutation.SetToken(s)
+	return ptc
+}
+
+// SetPlatform sets the "platform" field.
+func (ptc *PushTokenCreate) SetPlatform(s string) *PushTokenCreate {
+	ptc.mutation.SetPlatform(s)
+	return ptc
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (ptc *PushTokenCreate) SetNillablePlatform(s *string) *PushTokenCreate {
+	if s != nil {
+		ptc.SetPlatform(*s)
+	}
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *PushTokenCreate) SetCreatedAt(t time.Time) *PushTokenCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *PushTokenCreate) SetNillableCreatedAt(t *time.Time) *PushTokenCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptc *PushTokenCreate) SetUpdatedAt(t time.Time) *PushTokenCreate {
+	ptc.mutation.SetUpdatedAt(t)
+	return ptc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ptc *PushTokenCreate) SetNillableUpdatedAt(t *time.Time) *PushTokenCreate {
+	if t != nil {
+		ptc.SetUpdatedAt(*t)
+	}
+	return ptc
+}
+
+// SetID sets the "id" field.
+func (ptc *PushTokenCreate) SetID(u uuid.UUID) *PushTokenCreate {
+	ptc.mutation.SetID(u)
+	return ptc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ptc *PushTokenCreate) SetNillableID(u *uuid.UUID) *PushTokenCreate {
+	if u != nil {
+		ptc.SetID(*u)
+	}
+	return ptc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ptc *PushTokenCreate) SetUserID(id uuid.UUID) *PushTokenCreate {
+	ptc.mutation.SetUserID(id)
+	return ptc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptc *PushTokenCreate) SetUser(u *User) *PushTokenCreate {
+	return ptc.SetUserID(u.ID)
+}
+
+// Mutation returns the PushTokenMutation object of the builder.
+func (ptc *PushTokenCreate) Mutation() *PushTokenMutation {
+	return ptc.mutation
+}
+
+// Save creates the PushToken in the database.
+func (ptc *PushTokenCreate) Save(ctx context.Context) (*PushToken, error) {
+	ptc.defaults()
+	return withHooks(ctx, ptc.sqlSave, ptc.mutation, ptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *PushTokenCreate) SaveX(ctx context.Context) *PushToken {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *PushTokenCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *PushTokenCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *PushTokenCreate) defaults() {
+	if _, ok := ptc.mutation.Platform(); !ok {
+		v := pushtoken.DefaultPlatform
+		ptc.mutation.SetPlatform(v)
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := pushtoken.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ptc.mutation.UpdatedAt(); !ok {
+		v := pushtoken.DefaultUpdatedAt()
+		ptc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ptc.mutation.ID(); !ok {
+		v := pushtoken.DefaultID()
+		ptc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *PushTokenCreate) check() error {
+	if _, ok := ptc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "PushToken.token"`)}
+	}
+	if v, ok := ptc.mutation.Token(); ok {
+		if err := pushtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "PushToken.token": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "PushToken.platform"`)}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PushToken.created_at"`)}
+	}
+	if _, ok := ptc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PushToken.updated_at"`)}
+	}
+	if len(ptc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PushToken.user"`)}
+	}
+	return nil
+}
+
+func (ptc *PushTokenCreate) sqlSave(ctx context.Context) (*PushToken, error) {
+	if err := ptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ptc.mutation.id = &_node.ID
+	ptc.mutation.done = true
+	return _node, nil
+}
+
+func (ptc *PushTokenCreate) createSpec() (*PushToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PushToken{config: ptc.config}
+		_spec = sqlgraph.NewCreateSpec(pushtoken.Table, sqlgraph.NewFieldSpec(pushtoken.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ptc.conflict
+	if id, ok := ptc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ptc.mutation.Token(); ok {
+		_spec.SetField(pushtoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := ptc.mutation.Platform(); ok {
+		_spec.SetField(pushtoken.FieldPlatform, field.TypeString, value)
+		_node.Platform = value
+	}
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.SetField(pushtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ptc.mutation.UpdatedAt(); ok {
+		_spec.SetField(pushtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ptc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pushtoken.UserTable,
+			Columns: []string{pushtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_push_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PushToken.Create().
+//		SetToken(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PushTokenUpsert) {
+//			SetToken(v+v).
+//		}).
+//		Exec(ctx)
+func (ptc *PushTokenCreate) OnConflict(opts ...sql.ConflictOption) *PushTokenUpsertOne {
+	ptc.conflict = opts
+	return &PushTokenUpsertOne{
+		create: ptc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PushToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptc *PushTokenCreate) OnConflictColumns(columns ...string) *PushTokenUpsertOne {
+	ptc.conflict = append(ptc.conflict, sql.ConflictColumns(columns...))
+	return &PushTokenUpsertOne{
+		create: ptc,
+	}
+}
+
+type (
+	// PushTokenUpsertOne is the builder for "upsert"-ing
+	//  one PushToken node.
+	PushTokenUpsertOne struct {
+		create *PushTokenCreate
+	}
+
+	// PushTokenUpsert is the "OnConflict" setter.
+	PushTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetToken sets the "token" field.
+func (u *PushTokenUpsert) SetToken(v string) *PushTokenUpsert {
+	u.Set(pushtoken.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PushTokenUpsert) UpdateToken() *PushTokenUpsert {
+	u.SetExcluded(pushtoken.FieldToken)
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *PushTokenUpsert) SetPlatform(v string) *PushTokenUpsert {
+	u.Set(pushtoken.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *PushTokenUpsert) UpdatePlatform() *PushTokenUpsert {
+	u.SetExcluded(pushtoken.FieldPlatform)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PushTokenUpsert) SetCreatedAt(v time.Time) *PushTokenUpsert {
+	u.Set(pushtoken.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PushTokenUpsert) UpdateCreatedAt() *PushTokenUpsert {
+	u.SetExcluded(pushtoken.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PushTokenUpsert) SetUpdatedAt(v time.Time) *PushTokenUpsert {
+	u.Set(pushtoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PushTokenUpsert) UpdateUpdatedAt() *PushTokenUpsert {
+	u.SetExcluded(pushtoken.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PushToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pushtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PushTokenUpsertOne) UpdateNewValues() *PushTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pushtoken.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PushToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PushTokenUpsertOne) Ignore() *PushTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PushTokenUpsertOne) DoNothing() *PushTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PushTokenCreate.OnConflict
+// documentation for more info.
+func (u *PushTokenUpsertOne) Update(set func(*PushTokenUpsert)) *PushTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PushTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *PushTokenUpsertOne) SetToken(v string) *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PushTokenUpsertOne) UpdateToken() *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetPlatform sets the "platform" field.
+func (u *PushTokenUpsertOne) SetPlatform(v string) *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *PushTokenUpsertOne) UpdatePlatform() *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PushTokenUpsertOne) SetCreatedAt(v time.Time) *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PushTokenUpsertOne) UpdateCreatedAt() *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PushTokenUpsertOne) SetUpdatedAt(v time.Time) *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PushTokenUpsertOne) UpdateUpdatedAt() *PushTokenUpsertOne {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PushTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PushTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PushTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PushTokenUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PushTokenUpsertOne.ID is not supported by MySQL driver. Use PushTokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PushTokenUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PushTokenCreateBulk is the builder for creating many PushToken entities in bulk.
+type PushTokenCreateBulk struct {
+	config
+	err      error
+	builders []*PushTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PushToken entities in the database.
+func (ptcb *PushTokenCreateBulk) Save(ctx context.Context) ([]*PushToken, error) {
+	if ptcb.err != nil {
+		return nil, ptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*PushToken, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PushTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ptcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *PushTokenCreateBulk) SaveX(ctx context.Context) []*PushToken {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *PushTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *PushTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PushToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PushTokenUpsert) {
+//			SetToken(v+v).
+//		}).
+//		Exec(ctx)
+func (ptcb *PushTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *PushTokenUpsertBulk {
+	ptcb.conflict = opts
+	return &PushTokenUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PushToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptcb *PushTokenCreateBulk) OnConflictColumns(columns ...string) *PushTokenUpsertBulk {
+	ptcb.conflict = append(ptcb.conflict, sql.ConflictColumns(columns...))
+	return &PushTokenUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// PushTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of PushToken nodes.
+type PushTokenUpsertBulk struct {
+	create *PushTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PushToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pushtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PushTokenUpsertBulk) UpdateNewValues() *PushTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pushtoken.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PushToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PushTokenUpsertBulk) Ignore() *PushTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PushTokenUpsertBulk) DoNothing() *PushTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PushTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *PushTokenUpsertBulk) Update(set func(*PushTokenUpsert)) *PushTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PushTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *PushTokenUpsertBulk) SetToken(v string) *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PushTokenUpsertBulk) UpdateToken() *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetPlatform sets the "platform" field.
+func (u *PushTokenUpsertBulk) SetPlatform(v string) *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *PushTokenUpsertBulk) UpdatePlatform() *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PushTokenUpsertBulk) SetCreatedAt(v time.Time) *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PushTokenUpsertBulk) UpdateCreatedAt() *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PushTokenUpsertBulk) SetUpdatedAt(v time.Time) *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PushTokenUpsertBulk) UpdateUpdatedAt() *PushTokenUpsertBulk {
+	return u.Update(func(s *PushTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PushTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PushTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PushTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PushTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
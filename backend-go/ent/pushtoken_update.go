@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// PushTokenUpdate is the builder for updating PushToken entities.
+type PushTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PushTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PushTokenUpdate builder.
+func (ptu *PushTokenUpdate) Where(ps ...predicate.PushToken) *PushTokenUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetToken sets the "token" field.
+func (ptu *PushTokenUpdate) SetToken(s string) *PushTokenUpdate {
+	ptu.mutation.SetToken(s)
+	return ptu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ptu *PushTokenUpdate) SetNillableToken(s *string) *PushTokenUpdate {
+	if s != nil {
+		ptu.SetToken(*s)
+	}
+	return ptu
+}
+
+// SetPlatform sets the "platform" field.
+func (ptu *PushTokenUpdate) SetPlatform(s string) *PushTokenUpdate {
+	ptu.mutation.SetPlatform(s)
+	return ptu
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (ptu *PushTokenUpdate) SetNillablePlatform(s *string) *PushTokenUpdate {
+	if s != nil {
+		ptu.SetPlatform(*s)
+	}
+	return ptu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptu *PushTokenUpdate) SetCreatedAt(t time.Time) *PushTokenUpdate {
+	ptu.mutation.SetCreatedAt(t)
+	return ptu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptu *PushTokenUpdate) SetNillableCreatedAt(t *time.Time) *PushTokenUpdate {
+	if t != nil {
+		ptu.SetCreatedAt(*t)
+	}
+	return ptu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptu *PushTokenUpdate) SetUpdatedAt(t time.Time) *PushTokenUpdate {
+	ptu.mutation.SetUpdatedAt(t)
+	return ptu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ptu *PushTokenUpdate) SetNillableUpdatedAt(t *time.Time) *PushTokenUpdate {
+	if t != nil {
+		ptu.SetUpdatedAt(*t)
+	}
+	return ptu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ptu *PushTokenUpdate) SetUserID(id uuid.UUID) *PushTokenUpdate {
+	ptu.mutation.SetUserID(id)
+	return ptu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptu *PushTokenUpdate) SetUser(u *User) *PushTokenUpdate {
+	return ptu.SetUserID(u.ID)
+}
+
+// Mutation returns the PushTokenMutation object of the builder.
+func (ptu *PushTokenUpdate) Mutation() *PushTokenMutation {
+	return ptu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ptu *PushTokenUpdate) ClearUser() *PushTokenUpdate {
+	ptu.mutation.ClearUser()
+	return ptu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *PushTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *PushTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *PushTokenUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *PushTokenUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptu *PushTokenUpdate) check() error {
+	if v, ok := ptu.mutation.Token(); ok {
+		if err := pushtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "PushToken.token": %w`, err)}
+		}
+	}
+	if ptu.mutation.UserCleared() && len(ptu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PushToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ptu *PushTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PushTokenUpdate {
+	ptu.modifiers = append(ptu.modifiers, modifiers...)
+	return ptu
+}
+
+func (ptu *PushTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pushtoken.Table, pushtoken.Columns, sqlgraph.NewFieldSpec(pushtoken.FieldID, field.TypeUUID))
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptu.mutation.Token(); ok {
+		_spec.SetField(pushtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := ptu.mutation.Platform(); ok {
+		_spec.SetField(pushtoken.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := ptu.mutation.CreatedAt(); ok {
+		_spec.SetField(pushtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ptu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pushtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ptu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pushtoken.UserTable,
+			Columns: []string{pushtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pushtoken.UserTable,
+			Columns: []string{pushtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ptu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pushtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ptu.mutation.done = true
+	return n, nil
+}
+
+// PushTokenUpdateOne is the builder for updating a single PushToken entity.
+type PushTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PushTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetToken sets the "token" field.
+func (ptuo *PushTokenUpdateOne) SetToken(s string) *PushTokenUpdateOne {
+	ptuo.mutation.SetToken(s)
+	return ptuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ptuo *PushTokenUpdateOne) SetNillableToken(s *string) *PushTokenUpdateOne {
+	if s != nil {
+		ptuo.SetToken(*s)
+	}
+	return ptuo
+}
+
+// SetPlatform sets the "platform" field.
+func (ptuo *PushTokenUpdateOne) SetPlatform(s string) *PushTokenUpdateOne {
+	ptuo.mutation.SetPlatform(s)
+	return ptuo
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (ptuo *PushTokenUpdateOne) SetNillablePlatform(s *string) *PushTokenUpdateOne {
+	if s != nil {
+		ptuo.SetPlatform(*s)
+	}
+	return ptuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptuo *PushTokenUpdateOne) SetCreatedAt(t time.Time) *PushTokenUpdateOne {
+	ptuo.mutation.SetCreatedAt(t)
+	return ptuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptuo *PushTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *PushTokenUpdateOne {
+	if t != nil {
+		ptuo.SetCreatedAt(*t)
+	}
+	return ptuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptuo *PushTokenUpdateOne) SetUpdatedAt(t time.Time) *PushTokenUpdateOne {
+	ptuo.mutation.SetUpdatedAt(t)
+	return ptuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ptuo *PushTokenUpdateOne) SetNillableUpdatedAt(t *time.Time) *PushTokenUpdateOne {
+	if t != nil {
+		ptuo.SetUpdatedAt(*t)
+	}
+	return ptuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ptuo *PushTokenUpdateOne) SetUserID(id uuid.UUID) *PushTokenUpdateOne {
+	ptuo.mutation.SetUserID(id)
+	return ptuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptuo *PushTokenUpdateOne) SetUser(u *User) *PushTokenUpdateOne {
+	return ptuo.SetUserID(u.ID)
+}
+
+// Mutation returns the PushTokenMutation object of the builder.
+func (ptuo *PushTokenUpdateOne) Mutation() *PushTokenMutation {
+	return ptuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ptuo *PushTokenUpdateOne) ClearUser() *PushTokenUpdateOne {
+	ptuo.mutation.ClearUser()
+	return ptuo
+}
+
+// Where appends a list predicates to the PushTokenUpdate builder.
+func (ptuo *PushTokenUpdateOne) Where(ps ...predicate.PushToken) *PushTokenUpdateOne {
+	ptuo.mutation.Where(ps...)
+	return ptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *PushTokenUpdateOne) Select(field string, fields ...string) *PushTokenUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated PushToken entity.
+func (ptuo *PushTokenUpdateOne) Save(ctx context.Context) (*PushToken, error) {
+	return withHooks(ctx, ptuo.sqlSave, ptuo.mutation, ptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *PushTokenUpdateOne) SaveX(ctx context.Context) *PushToken {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *PushTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *PushTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *PushTokenUpdateOne) check() error {
+	if v, ok := ptuo.mutation.Token(); ok {
+		if err := pushtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "PushToken.token": %w`, err)}
+		}
+	}
+	if ptuo.mutation.UserCleared() && len(ptuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PushToken.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ptuo *PushTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PushTokenUpdateOne {
+	ptuo.modifiers = append(ptuo.modifiers, modifiers...)
+	return ptuo
+}
+
+func (ptuo *PushTokenUpdateOne) sqlSave(ctx context.Context) (_node *PushToken, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pushtoken.Table, pushtoken.Columns, sqlgraph.NewFieldSpec(pushtoken.FieldID, field.TypeUUID))
+	id, ok := ptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PushToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pushtoken.FieldID)
+		for _, f := range fields {
+			if !pushtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pushtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptuo.mutation.Token(); ok {
+		_spec.SetField(pushtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := ptuo.mutation.Platform(); ok {
+		_spec.SetField(pushtoken.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := ptuo.mutation.CreatedAt(); ok {
+		_spec.SetField(pushtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ptuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pushtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ptuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pushtoken.UserTable,
+			Columns: []string{pushtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pushtoken.UserTable,
+			Columns: []string{pushtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ptuo.modifiers...)
+	_node = &PushToken{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pushtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ptuo.mutation.done = true
+	return _node, nil
+}
@@ -8,10 +8,16 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
 	"github.com/aki-13627/animalia/backend-go/ent/schema"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/ent/tasktype"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -22,14 +28,16 @@ import (
 func init() {
 	commentFields := schema.Comment{}.Fields()
 	_ = commentFields
-	// commentDescContent is the schema descriptor for content field.
-	commentDescContent := commentFields[1].Descriptor()
-	// comment.ContentValidator is a validator for the "content" field. It is called by the builders before save.
-	comment.ContentValidator = commentDescContent.Validators[0].(func(string) error)
 	// commentDescCreatedAt is the schema descriptor for created_at field.
-	commentDescCreatedAt := commentFields[2].Descriptor()
+	commentDescCreatedAt := commentFields[3].Descriptor()
 	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[4].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// commentDescID is the schema descriptor for id field.
 	commentDescID := commentFields[0].Descriptor()
 	// comment.DefaultID holds the default value on creation for the id field.
@@ -54,6 +62,16 @@ func init() {
 	followrelationDescID := followrelationFields[0].Descriptor()
 	// followrelation.DefaultID holds the default value on creation for the id field.
 	followrelation.DefaultID = followrelationDescID.Default.(func() uuid.UUID)
+	indexcounterFields := schema.IndexCounter{}.Fields()
+	_ = indexcounterFields
+	// indexcounterDescName is the schema descriptor for name field.
+	indexcounterDescName := indexcounterFields[0].Descriptor()
+	// indexcounter.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	indexcounter.NameValidator = indexcounterDescName.Validators[0].(func(string) error)
+	// indexcounterDescNextValue is the schema descriptor for next_value field.
+	indexcounterDescNextValue := indexcounterFields[1].Descriptor()
+	// indexcounter.NextValueValidator is a validator for the "next_value" field. It is called by the builders before save.
+	indexcounter.NextValueValidator = indexcounterDescNextValue.Validators[0].(func(int) error)
 	likeFields := schema.Like{}.Fields()
 	_ = likeFields
 	// likeDescCreatedAt is the schema descriptor for created_at field.
@@ -64,6 +82,46 @@ func init() {
 	likeDescID := likeFields[0].Descriptor()
 	// like.DefaultID holds the default value on creation for the id field.
 	like.DefaultID = likeDescID.Default.(func() uuid.UUID)
+	localaccountFields := schema.LocalAccount{}.Fields()
+	_ = localaccountFields
+	// localaccountDescEmail is the schema descriptor for email field.
+	localaccountDescEmail := localaccountFields[1].Descriptor()
+	// localaccount.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	localaccount.EmailValidator = localaccountDescEmail.Validators[0].(func(string) error)
+	// localaccountDescEmailVerified is the schema descriptor for email_verified field.
+	localaccountDescEmailVerified := localaccountFields[3].Descriptor()
+	// localaccount.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	localaccount.DefaultEmailVerified = localaccountDescEmailVerified.Default.(bool)
+	// localaccountDescTokenVersion is the schema descriptor for token_version field.
+	localaccountDescTokenVersion := localaccountFields[6].Descriptor()
+	// localaccount.DefaultTokenVersion holds the default value on creation for the token_version field.
+	localaccount.DefaultTokenVersion = localaccountDescTokenVersion.Default.(int)
+	// localaccountDescCreatedAt is the schema descriptor for created_at field.
+	localaccountDescCreatedAt := localaccountFields[7].Descriptor()
+	// localaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	localaccount.DefaultCreatedAt = localaccountDescCreatedAt.Default.(func() time.Time)
+	// localaccountDescID is the schema descriptor for id field.
+	localaccountDescID := localaccountFields[0].Descriptor()
+	// localaccount.DefaultID holds the default value on creation for the id field.
+	localaccount.DefaultID = localaccountDescID.Default.(func() uuid.UUID)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescRead is the schema descriptor for read field.
+	notificationDescRead := notificationFields[4].Descriptor()
+	// notification.DefaultRead holds the default value on creation for the read field.
+	notification.DefaultRead = notificationDescRead.Default.(bool)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[5].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
 	petFields := schema.Pet{}.Fields()
 	_ = petFields
 	// petDescName is the schema descriptor for name field.
@@ -104,10 +162,68 @@ func init() {
 	postDescCreatedAt := postFields[4].Descriptor()
 	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
 	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
+	// postDescImageReapAttempts is the schema descriptor for image_reap_attempts field.
+	postDescImageReapAttempts := postFields[7].Descriptor()
+	// post.DefaultImageReapAttempts holds the default value on creation for the image_reap_attempts field.
+	post.DefaultImageReapAttempts = postDescImageReapAttempts.Default.(int)
+	// post.ImageReapAttemptsValidator is a validator for the "image_reap_attempts" field. It is called by the builders before save.
+	post.ImageReapAttemptsValidator = postDescImageReapAttempts.Validators[0].(func(int) error)
 	// postDescID is the schema descriptor for id field.
 	postDescID := postFields[0].Descriptor()
 	// post.DefaultID holds the default value on creation for the id field.
 	post.DefaultID = postDescID.Default.(func() uuid.UUID)
+	pushtokenFields := schema.PushToken{}.Fields()
+	_ = pushtokenFields
+	// pushtokenDescToken is the schema descriptor for token field.
+	pushtokenDescToken := pushtokenFields[1].Descriptor()
+	// pushtoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	pushtoken.TokenValidator = pushtokenDescToken.Validators[0].(func(string) error)
+	// pushtokenDescPlatform is the schema descriptor for platform field.
+	pushtokenDescPlatform := pushtokenFields[2].Descriptor()
+	// pushtoken.DefaultPlatform holds the default value on creation for the platform field.
+	pushtoken.DefaultPlatform = pushtokenDescPlatform.Default.(string)
+	// pushtokenDescCreatedAt is the schema descriptor for created_at field.
+	pushtokenDescCreatedAt := pushtokenFields[3].Descriptor()
+	// pushtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pushtoken.DefaultCreatedAt = pushtokenDescCreatedAt.Default.(func() time.Time)
+	// pushtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	pushtokenDescUpdatedAt := pushtokenFields[4].Descriptor()
+	// pushtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pushtoken.DefaultUpdatedAt = pushtokenDescUpdatedAt.Default.(func() time.Time)
+	// pushtokenDescID is the schema descriptor for id field.
+	pushtokenDescID := pushtokenFields[0].Descriptor()
+	// pushtoken.DefaultID holds the default value on creation for the id field.
+	pushtoken.DefaultID = pushtokenDescID.Default.(func() uuid.UUID)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	tasktypeFields := schema.TaskType{}.Fields()
+	_ = tasktypeFields
+	// tasktypeDescTitle is the schema descriptor for title field.
+	tasktypeDescTitle := tasktypeFields[1].Descriptor()
+	// tasktype.DefaultTitle holds the default value on creation for the title field.
+	tasktype.DefaultTitle = tasktypeDescTitle.Default.(string)
+	// tasktypeDescWeight is the schema descriptor for weight field.
+	tasktypeDescWeight := tasktypeFields[4].Descriptor()
+	// tasktype.DefaultWeight holds the default value on creation for the weight field.
+	tasktype.DefaultWeight = tasktypeDescWeight.Default.(int)
+	// tasktype.WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	tasktype.WeightValidator = tasktypeDescWeight.Validators[0].(func(int) error)
+	// tasktypeDescActive is the schema descriptor for active field.
+	tasktypeDescActive := tasktypeFields[5].Descriptor()
+	// tasktype.DefaultActive holds the default value on creation for the active field.
+	tasktype.DefaultActive = tasktypeDescActive.Default.(bool)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescIndex is the schema descriptor for index field.
@@ -126,8 +242,12 @@ func init() {
 	userDescBio := userFields[4].Descriptor()
 	// user.DefaultBio holds the default value on creation for the bio field.
 	user.DefaultBio = userDescBio.Default.(string)
+	// userDescIsAdmin is the schema descriptor for is_admin field.
+	userDescIsAdmin := userFields[6].Descriptor()
+	// user.DefaultIsAdmin holds the default value on creation for the is_admin field.
+	user.DefaultIsAdmin = userDescIsAdmin.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
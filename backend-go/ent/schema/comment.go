@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -18,15 +20,33 @@ type Comment struct {
 func (Comment) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
-		field.String("content").NotEmpty(),
+		// 削除されたコメントに返信が残る場合は空にしてプレースホルダーとして残す
+		field.String("content"),
+		// コメントされた投稿の ID。post エッジの外部キーで、コメント一覧のインデックスの先頭に置く
+		field.UUID("post_comments", uuid.UUID{}),
 		field.Time("created_at").Default(time.Now),
+		// 既存のコメントに列を追加できるよう、DB 側にもデフォルト値を持たせる
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+		field.Time("edited_at").Optional().Nillable(),
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
 // Edges of the Comment.
 func (Comment) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("post", Post.Type).Ref("comments").Unique().Required(),
+		edge.From("post", Post.Type).Ref("comments").Field("post_comments").Unique().Required(),
 		edge.From("user", User.Type).Ref("comments").Unique().Required(),
+		// Replies are one level deep: a reply to a reply is attached to the top-level comment
+		edge.To("replies", Comment.Type).From("parent").Unique(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		// コメント一覧のカーソルページング用
+		index.Fields("post_comments", "created_at", "id"),
 	}
 }
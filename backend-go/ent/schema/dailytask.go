@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
@@ -31,3 +32,9 @@ func (DailyTask) Edges() []ent.Edge {
 		edge.From("post", Post.Type).Ref("daily_task").Unique(),
 	}
 }
+
+func (DailyTask) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user").Fields("created_at"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -29,3 +30,9 @@ func (FollowRelation) Edges() []ent.Edge {
 		edge.From("to", User.Type).Ref("followers").Unique().Required(),
 	}
 }
+
+func (FollowRelation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("from", "to").Unique(),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// IndexCounter holds the schema definition for the IndexCounter entity.
+// It allocates the dense User.index and Post.index used by the recommender.
+type IndexCounter struct {
+	ent.Schema
+}
+
+// Fields of the IndexCounter.
+func (IndexCounter) Fields() []ent.Field {
+	return []ent.Field{
+		// 採番対象のテーブル名 (users, posts)
+		field.String("name").Unique().NotEmpty(),
+		// 次に割り当てる index
+		field.Int("next_value").NonNegative(),
+	}
+}
+
+// Edges of the IndexCounter.
+func (IndexCounter) Edges() []ent.Edge {
+	return nil
+}
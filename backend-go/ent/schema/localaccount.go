@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LocalAccount holds the schema definition for the LocalAccount entity.
+// It stores the credentials of the local auth provider (AUTH_PROVIDER=local), which stands in for Cognito.
+type LocalAccount struct {
+	ent.Schema
+}
+
+// Fields of the LocalAccount.
+func (LocalAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("email").NotEmpty().Unique(),
+		// bcrypt ハッシュ
+		field.String("password_hash").Sensitive(),
+		field.Bool("email_verified").Default(false),
+		field.String("verification_code").Optional().Sensitive(),
+		field.Time("verification_expires_at").Optional().Nillable(),
+		// サインアウトで加算し、それ以前に発行したトークンを無効にする
+		field.Int("token_version").Default(0),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the LocalAccount.
+func (LocalAccount) Edges() []ent.Edge {
+	return nil
+}
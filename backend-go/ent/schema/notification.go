@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// Repeated events of the same kind on the same subject are collapsed into one unread notification.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// 受信したユーザーの ID。recipient エッジの外部キーで、通知一覧のインデックスの先頭に置く
+		field.UUID("user_notifications", uuid.UUID{}),
+		field.Enum("kind").Values("like", "comment", "follow"),
+		// まとめられた操作をしたユーザーの ID (新しい順、重複なし)
+		field.JSON("actor_ids", []uuid.UUID{}),
+		field.Bool("read").Default(false),
+		field.Time("created_at").Default(time.Now),
+		// 最後に操作がまとめられた日時。一覧はこの順に並べる
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("recipient", User.Type).Ref("notifications").Field("user_notifications").Unique().Required(),
+		// 最後に操作したユーザー
+		edge.To("actor", User.Type).Unique().Required(),
+		// いいね・コメントされた投稿。フォローの場合は空
+		edge.To("post", Post.Type).Unique(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		// 通知一覧のカーソルページング用
+		index.Fields("user_notifications", "updated_at", "id"),
+		// 未読の通知は投稿と種類ごとに 1 件だけにし、同時に記録されても upsert でまとめられるようにする
+		index.Fields("user_notifications", "kind").Edges("post").
+			Unique().
+			StorageKey("notification_unread_post").
+			Annotations(entsql.IndexWhere("read = false AND notification_post IS NOT NULL")),
+		// フォローの未読通知はユーザーごとに 1 件だけ
+		index.Fields("user_notifications").
+			Unique().
+			StorageKey("notification_unread_follow").
+			Annotations(entsql.IndexWhere("read = false AND kind = 'follow'")),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 )
@@ -25,6 +26,12 @@ func (Post) Fields() []ent.Field {
 		field.String("image_key").NotEmpty(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("deleted_at").Optional(),
+		// 論理削除後に S3 の画像を削除した日時
+		field.Time("image_deleted_at").Optional(),
+		// 画像の削除に失敗した回数。失敗が続く投稿は後回しにする
+		field.Int("image_reap_attempts").Default(0).NonNegative(),
+		// タスク投稿の画像とタスク文の類似度 (0〜100)。タスクなしの投稿や採点に失敗した場合は NULL
+		field.Float("task_score").Optional().Nillable(),
 		
 		field.Other("image_feature", pgvector.Vector{}).
 			SchemaType(map[string]string{
@@ -40,5 +47,15 @@ func (Post) Edges() []ent.Edge {
 		edge.To("comments", Comment.Type),
 		edge.To("likes", Like.Type),
 		edge.To("daily_task", DailyTask.Type).Unique(),
+		// キャプションのハッシュタグ
+		edge.From("tags", Tag.Type).Ref("posts"),
+	}
+}
+
+// Indexes of the Post.
+func (Post) Indexes() []ent.Index {
+	return []ent.Index{
+		// タイムラインのカーソルページング用
+		index.Fields("created_at", "id"),
 	}
 }
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PushToken holds the schema definition for the PushToken entity.
+// It is the Expo push token of a device the user signed in on.
+type PushToken struct {
+	ent.Schema
+}
+
+// Fields of the PushToken.
+func (PushToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// ExponentPushToken[...] の形式。端末でログインし直すと別のユーザーに付け替える
+		field.String("token").NotEmpty().Unique(),
+		// ios, android
+		field.String("platform").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the PushToken.
+func (PushToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("push_tokens").Unique().Required(),
+	}
+}
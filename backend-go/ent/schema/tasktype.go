@@ -16,7 +16,15 @@ type TaskType struct {
 // Fields of the TaskType.
 func (TaskType) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("type").GoType(enum.TypeEating),
+		field.String("type").GoType(enum.TypeEating).Unique(),
+		field.String("title").Default(""),
+		// 言語コードごとのタスク文言 (例: {"ja": "...", "en": "..."})
+		field.JSON("prompt", map[string]string{}).Optional(),
+		// 対象となるペットの種類 (Pet.type)。空の場合はすべてのペットが対象
+		field.Strings("pet_types").Optional(),
+		// 抽選時の重み
+		field.Int("weight").Default(1).Positive(),
+		field.Bool("active").Default(true),
 		field.Other("text_feature", pgvector.Vector{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "vector(768)",
@@ -23,6 +23,9 @@ func (User) Fields() []ent.Field {
 		field.String("name").NotEmpty(),
 		field.String("bio").Default(""),
 		field.String("icon_image_key").Optional(),
+		field.Bool("is_admin").Default(false),
+		// プッシュ通知を受け取らない通知の種類 (like, comment, follow)
+		field.Strings("push_opt_outs").Optional(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -37,5 +40,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("following", FollowRelation.Type),
 		edge.To("followers", FollowRelation.Type),
 		edge.To("daily_tasks", DailyTask.Type),
+		edge.To("notifications", Notification.Type),
+		edge.To("push_tokens", PushToken.Type),
 	}
 }
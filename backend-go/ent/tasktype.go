@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -20,6 +21,16 @@ type TaskType struct {
 	ID int `json:"id,omitempty"`
 	// Type holds the value of the "type" field.
 	Type enum.TaskType `json:"type,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Prompt holds the value of the "prompt" field.
+	Prompt map[string]string `json:"prompt,omitempty"`
+	// PetTypes holds the value of the "pet_types" field.
+	PetTypes []string `json:"pet_types,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight int `json:"weight,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
 	// TextFeature holds the value of the "text_feature" field.
 	TextFeature  pgvector.Vector `json:"text_feature,omitempty"`
 	selectValues sql.SelectValues
@@ -30,11 +41,15 @@ func (*TaskType) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tasktype.FieldPrompt, tasktype.FieldPetTypes:
+			values[i] = new([]byte)
 		case tasktype.FieldTextFeature:
 			values[i] = new(pgvector.Vector)
-		case tasktype.FieldID:
+		case tasktype.FieldActive:
+			values[i] = new(sql.NullBool)
+		case tasktype.FieldID, tasktype.FieldWeight:
 			values[i] = new(sql.NullInt64)
-		case tasktype.FieldType:
+		case tasktype.FieldType, tasktype.FieldTitle:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -63,6 +78,40 @@ func (tt *TaskType) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				tt.Type = enum.TaskType(value.String)
 			}
+		case tasktype.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				tt.Title = value.String
+			}
+		case tasktype.FieldPrompt:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.Prompt); err != nil {
+					return fmt.Errorf("unmarshal field prompt: %w", err)
+				}
+			}
+		case tasktype.FieldPetTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pet_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.PetTypes); err != nil {
+					return fmt.Errorf("unmarshal field pet_types: %w", err)
+				}
+			}
+		case tasktype.FieldWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				tt.Weight = int(value.Int64)
+			}
+		case tasktype.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				tt.Active = value.Bool
+			}
 		case tasktype.FieldTextFeature:
 			if value, ok := values[i].(*pgvector.Vector); !ok {
 				return fmt.Errorf("unexpected type %T for field text_feature", values[i])
@@ -108,6 +157,21 @@ func (tt *TaskType) String() string {
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", tt.Type))
 	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(tt.Title)
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Prompt))
+	builder.WriteString(", ")
+	builder.WriteString("pet_types=")
+	builder.WriteString(fmt.Sprintf("%v", tt.PetTypes))
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Active))
+	builder.WriteString(", ")
 	builder.WriteString("text_feature=")
 	builder.WriteString(fmt.Sprintf("%v", tt.TextFeature))
 	builder.WriteByte(')')
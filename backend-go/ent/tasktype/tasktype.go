@@ -13,6 +13,16 @@ const (
 	FieldID = "id"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldPrompt holds the string denoting the prompt field in the database.
+	FieldPrompt = "prompt"
+	// FieldPetTypes holds the string denoting the pet_types field in the database.
+	FieldPetTypes = "pet_types"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
 	// FieldTextFeature holds the string denoting the text_feature field in the database.
 	FieldTextFeature = "text_feature"
 	// Table holds the table name of the tasktype in the database.
@@ -23,6 +33,11 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldType,
+	FieldTitle,
+	FieldPrompt,
+	FieldPetTypes,
+	FieldWeight,
+	FieldActive,
 	FieldTextFeature,
 }
 
@@ -36,6 +51,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultTitle holds the default value on creation for the "title" field.
+	DefaultTitle string
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight int
+	// WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	WeightValidator func(int) error
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+)
+
 // OrderOption defines the ordering options for the TaskType queries.
 type OrderOption func(*sql.Selector)
 
@@ -49,6 +75,21 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
 // ByTextFeature orders the results by the text_feature field.
 func ByTextFeature(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTextFeature, opts...).ToFunc()
@@ -60,6 +60,21 @@ func Type(v enum.TaskType) predicate.TaskType {
 	return predicate.TaskType(sql.FieldEQ(FieldType, vc))
 }
 
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEQ(FieldTitle, v))
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEQ(FieldWeight, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEQ(FieldActive, v))
+}
+
 // TextFeature applies equality check predicate on the "text_feature" field. It's identical to TextFeatureEQ.
 func TextFeature(v pgvector.Vector) predicate.TaskType {
 	return predicate.TaskType(sql.FieldEQ(FieldTextFeature, v))
@@ -149,6 +164,141 @@ func TypeContainsFold(v enum.TaskType) predicate.TaskType {
 	return predicate.TaskType(sql.FieldContainsFold(FieldType, vc))
 }
 
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TaskType {
+	return predicate.TaskType(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// PromptIsNil applies the IsNil predicate on the "prompt" field.
+func PromptIsNil() predicate.TaskType {
+	return predicate.TaskType(sql.FieldIsNull(FieldPrompt))
+}
+
+// PromptNotNil applies the NotNil predicate on the "prompt" field.
+func PromptNotNil() predicate.TaskType {
+	return predicate.TaskType(sql.FieldNotNull(FieldPrompt))
+}
+
+// PetTypesIsNil applies the IsNil predicate on the "pet_types" field.
+func PetTypesIsNil() predicate.TaskType {
+	return predicate.TaskType(sql.FieldIsNull(FieldPetTypes))
+}
+
+// PetTypesNotNil applies the NotNil predicate on the "pet_types" field.
+func PetTypesNotNil() predicate.TaskType {
+	return predicate.TaskType(sql.FieldNotNull(FieldPetTypes))
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v int) predicate.TaskType {
+	return predicate.TaskType(sql.FieldLTE(FieldWeight, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.TaskType {
+	return predicate.TaskType(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.TaskType {
+	return predicate.TaskType(sql.FieldNEQ(FieldActive, v))
+}
+
 // TextFeatureEQ applies the EQ predicate on the "text_feature" field.
 func TextFeatureEQ(v pgvector.Vector) predicate.TaskType {
 	return predicate.TaskType(sql.FieldEQ(FieldTextFeature, v))
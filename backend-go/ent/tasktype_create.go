@@ -29,6 +29,60 @@ func (ttc *TaskTypeCreate) SetType(et enum.TaskType) *TaskTypeCreate {
 	return ttc
 }
 
+// SetTitle sets the "title" field.
+func (ttc *TaskTypeCreate) SetTitle(s string) *TaskTypeCreate {
+	ttc.mutation.SetTitle(s)
+	return ttc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ttc *TaskTypeCreate) SetNillableTitle(s *string) *TaskTypeCreate {
+	if s != nil {
+		ttc.SetTitle(*s)
+	}
+	return ttc
+}
+
+// SetPrompt sets the "prompt" field.
+func (ttc *TaskTypeCreate) SetPrompt(m map[string]string) *TaskTypeCreate {
+	ttc.mutation.SetPrompt(m)
+	return ttc
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (ttc *TaskTypeCreate) SetPetTypes(s []string) *TaskTypeCreate {
+	ttc.mutation.SetPetTypes(s)
+	return ttc
+}
+
+// SetWeight sets the "weight" field.
+func (ttc *TaskTypeCreate) SetWeight(i int) *TaskTypeCreate {
+	ttc.mutation.SetWeight(i)
+	return ttc
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (ttc *TaskTypeCreate) SetNillableWeight(i *int) *TaskTypeCreate {
+	if i != nil {
+		ttc.SetWeight(*i)
+	}
+	return ttc
+}
+
+// SetActive sets the "active" field.
+func (ttc *TaskTypeCreate) SetActive(b bool) *TaskTypeCreate {
+	ttc.mutation.SetActive(b)
+	return ttc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (ttc *TaskTypeCreate) SetNillableActive(b *bool) *TaskTypeCreate {
+	if b != nil {
+		ttc.SetActive(*b)
+	}
+	return ttc
+}
+
 // SetTextFeature sets the "text_feature" field.
 func (ttc *TaskTypeCreate) SetTextFeature(pg pgvector.Vector) *TaskTypeCreate {
 	ttc.mutation.SetTextFeature(pg)
@@ -50,6 +104,7 @@ func (ttc *TaskTypeCreate) Mutation() *TaskTypeMutation {
 
 // Save creates the TaskType in the database.
 func (ttc *TaskTypeCreate) Save(ctx context.Context) (*TaskType, error) {
+	ttc.defaults()
 	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
 }
 
@@ -75,11 +130,41 @@ func (ttc *TaskTypeCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (ttc *TaskTypeCreate) defaults() {
+	if _, ok := ttc.mutation.Title(); !ok {
+		v := tasktype.DefaultTitle
+		ttc.mutation.SetTitle(v)
+	}
+	if _, ok := ttc.mutation.Weight(); !ok {
+		v := tasktype.DefaultWeight
+		ttc.mutation.SetWeight(v)
+	}
+	if _, ok := ttc.mutation.Active(); !ok {
+		v := tasktype.DefaultActive
+		ttc.mutation.SetActive(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (ttc *TaskTypeCreate) check() error {
 	if _, ok := ttc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TaskType.type"`)}
 	}
+	if _, ok := ttc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TaskType.title"`)}
+	}
+	if _, ok := ttc.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "TaskType.weight"`)}
+	}
+	if v, ok := ttc.mutation.Weight(); ok {
+		if err := tasktype.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "TaskType.weight": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "TaskType.active"`)}
+	}
 	return nil
 }
 
@@ -111,6 +196,26 @@ func (ttc *TaskTypeCreate) createSpec() (*TaskType, *sqlgraph.CreateSpec) {
 		_spec.SetField(tasktype.FieldType, field.TypeString, value)
 		_node.Type = value
 	}
+	if value, ok := ttc.mutation.Title(); ok {
+		_spec.SetField(tasktype.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ttc.mutation.Prompt(); ok {
+		_spec.SetField(tasktype.FieldPrompt, field.TypeJSON, value)
+		_node.Prompt = value
+	}
+	if value, ok := ttc.mutation.PetTypes(); ok {
+		_spec.SetField(tasktype.FieldPetTypes, field.TypeJSON, value)
+		_node.PetTypes = value
+	}
+	if value, ok := ttc.mutation.Weight(); ok {
+		_spec.SetField(tasktype.FieldWeight, field.TypeInt, value)
+		_node.Weight = value
+	}
+	if value, ok := ttc.mutation.Active(); ok {
+		_spec.SetField(tasktype.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
 	if value, ok := ttc.mutation.TextFeature(); ok {
 		_spec.SetField(tasktype.FieldTextFeature, field.TypeOther, value)
 		_node.TextFeature = value
@@ -179,6 +284,84 @@ func (u *TaskTypeUpsert) UpdateType() *TaskTypeUpsert {
 	return u
 }
 
+// SetTitle sets the "title" field.
+func (u *TaskTypeUpsert) SetTitle(v string) *TaskTypeUpsert {
+	u.Set(tasktype.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TaskTypeUpsert) UpdateTitle() *TaskTypeUpsert {
+	u.SetExcluded(tasktype.FieldTitle)
+	return u
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *TaskTypeUpsert) SetPrompt(v map[string]string) *TaskTypeUpsert {
+	u.Set(tasktype.FieldPrompt, v)
+	return u
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *TaskTypeUpsert) UpdatePrompt() *TaskTypeUpsert {
+	u.SetExcluded(tasktype.FieldPrompt)
+	return u
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *TaskTypeUpsert) ClearPrompt() *TaskTypeUpsert {
+	u.SetNull(tasktype.FieldPrompt)
+	return u
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (u *TaskTypeUpsert) SetPetTypes(v []string) *TaskTypeUpsert {
+	u.Set(tasktype.FieldPetTypes, v)
+	return u
+}
+
+// UpdatePetTypes sets the "pet_types" field to the value that was provided on create.
+func (u *TaskTypeUpsert) UpdatePetTypes() *TaskTypeUpsert {
+	u.SetExcluded(tasktype.FieldPetTypes)
+	return u
+}
+
+// ClearPetTypes clears the value of the "pet_types" field.
+func (u *TaskTypeUpsert) ClearPetTypes() *TaskTypeUpsert {
+	u.SetNull(tasktype.FieldPetTypes)
+	return u
+}
+
+// SetWeight sets the "weight" field.
+func (u *TaskTypeUpsert) SetWeight(v int) *TaskTypeUpsert {
+	u.Set(tasktype.FieldWeight, v)
+	return u
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *TaskTypeUpsert) UpdateWeight() *TaskTypeUpsert {
+	u.SetExcluded(tasktype.FieldWeight)
+	return u
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *TaskTypeUpsert) AddWeight(v int) *TaskTypeUpsert {
+	u.Add(tasktype.FieldWeight, v)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *TaskTypeUpsert) SetActive(v bool) *TaskTypeUpsert {
+	u.Set(tasktype.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *TaskTypeUpsert) UpdateActive() *TaskTypeUpsert {
+	u.SetExcluded(tasktype.FieldActive)
+	return u
+}
+
 // SetTextFeature sets the "text_feature" field.
 func (u *TaskTypeUpsert) SetTextFeature(v pgvector.Vector) *TaskTypeUpsert {
 	u.Set(tasktype.FieldTextFeature, v)
@@ -251,6 +434,97 @@ func (u *TaskTypeUpsertOne) UpdateType() *TaskTypeUpsertOne {
 	})
 }
 
+// SetTitle sets the "title" field.
+func (u *TaskTypeUpsertOne) SetTitle(v string) *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TaskTypeUpsertOne) UpdateTitle() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *TaskTypeUpsertOne) SetPrompt(v map[string]string) *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *TaskTypeUpsertOne) UpdatePrompt() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *TaskTypeUpsertOne) ClearPrompt() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.ClearPrompt()
+	})
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (u *TaskTypeUpsertOne) SetPetTypes(v []string) *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetPetTypes(v)
+	})
+}
+
+// UpdatePetTypes sets the "pet_types" field to the value that was provided on create.
+func (u *TaskTypeUpsertOne) UpdatePetTypes() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdatePetTypes()
+	})
+}
+
+// ClearPetTypes clears the value of the "pet_types" field.
+func (u *TaskTypeUpsertOne) ClearPetTypes() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.ClearPetTypes()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *TaskTypeUpsertOne) SetWeight(v int) *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *TaskTypeUpsertOne) AddWeight(v int) *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *TaskTypeUpsertOne) UpdateWeight() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *TaskTypeUpsertOne) SetActive(v bool) *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *TaskTypeUpsertOne) UpdateActive() *TaskTypeUpsertOne {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdateActive()
+	})
+}
+
 // SetTextFeature sets the "text_feature" field.
 func (u *TaskTypeUpsertOne) SetTextFeature(v pgvector.Vector) *TaskTypeUpsertOne {
 	return u.Update(func(s *TaskTypeUpsert) {
@@ -324,6 +598,7 @@ func (ttcb *TaskTypeCreateBulk) Save(ctx context.Context) ([]*TaskType, error) {
 	for i := range ttcb.builders {
 		func(i int, root context.Context) {
 			builder := ttcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TaskTypeMutation)
 				if !ok {
@@ -489,6 +764,97 @@ func (u *TaskTypeUpsertBulk) UpdateType() *TaskTypeUpsertBulk {
 	})
 }
 
+// SetTitle sets the "title" field.
+func (u *TaskTypeUpsertBulk) SetTitle(v string) *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TaskTypeUpsertBulk) UpdateTitle() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *TaskTypeUpsertBulk) SetPrompt(v map[string]string) *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *TaskTypeUpsertBulk) UpdatePrompt() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *TaskTypeUpsertBulk) ClearPrompt() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.ClearPrompt()
+	})
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (u *TaskTypeUpsertBulk) SetPetTypes(v []string) *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetPetTypes(v)
+	})
+}
+
+// UpdatePetTypes sets the "pet_types" field to the value that was provided on create.
+func (u *TaskTypeUpsertBulk) UpdatePetTypes() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdatePetTypes()
+	})
+}
+
+// ClearPetTypes clears the value of the "pet_types" field.
+func (u *TaskTypeUpsertBulk) ClearPetTypes() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.ClearPetTypes()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *TaskTypeUpsertBulk) SetWeight(v int) *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *TaskTypeUpsertBulk) AddWeight(v int) *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *TaskTypeUpsertBulk) UpdateWeight() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *TaskTypeUpsertBulk) SetActive(v bool) *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *TaskTypeUpsertBulk) UpdateActive() *TaskTypeUpsertBulk {
+	return u.Update(func(s *TaskTypeUpsert) {
+		s.UpdateActive()
+	})
+}
+
 // SetTextFeature sets the "text_feature" field.
 func (u *TaskTypeUpsertBulk) SetTextFeature(v pgvector.Vector) *TaskTypeUpsertBulk {
 	return u.Update(func(s *TaskTypeUpsert) {
@@ -22,6 +22,7 @@ type TaskTypeQuery struct {
 	order      []tasktype.OrderOption
 	inters     []Interceptor
 	predicates []predicate.TaskType
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -251,8 +252,9 @@ func (ttq *TaskTypeQuery) Clone() *TaskTypeQuery {
 		inters:     append([]Interceptor{}, ttq.inters...),
 		predicates: append([]predicate.TaskType{}, ttq.predicates...),
 		// clone intermediate query.
-		sql:  ttq.sql.Clone(),
-		path: ttq.path,
+		sql:       ttq.sql.Clone(),
+		path:      ttq.path,
+		modifiers: append([]func(*sql.Selector){}, ttq.modifiers...),
 	}
 }
 
@@ -343,6 +345,9 @@ func (ttq *TaskTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ta
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (ttq *TaskTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ta
 
 func (ttq *TaskTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ttq.querySpec()
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
 	_spec.Node.Columns = ttq.ctx.Fields
 	if len(ttq.ctx.Fields) > 0 {
 		_spec.Unique = ttq.ctx.Unique != nil && *ttq.ctx.Unique
@@ -419,6 +427,9 @@ func (ttq *TaskTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ttq.ctx.Unique != nil && *ttq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ttq.modifiers {
+		m(selector)
+	}
 	for _, p := range ttq.predicates {
 		p(selector)
 	}
@@ -436,6 +447,12 @@ func (ttq *TaskTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ttq *TaskTypeQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskTypeSelect {
+	ttq.modifiers = append(ttq.modifiers, modifiers...)
+	return ttq.Select()
+}
+
 // TaskTypeGroupBy is the group-by builder for TaskType entities.
 type TaskTypeGroupBy struct {
 	selector
@@ -525,3 +542,9 @@ func (tts *TaskTypeSelect) sqlScan(ctx context.Context, root *TaskTypeQuery, v a
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tts *TaskTypeSelect) Modify(modifiers ...func(s *sql.Selector)) *TaskTypeSelect {
+	tts.modifiers = append(tts.modifiers, modifiers...)
+	return tts
+}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
@@ -19,8 +20,9 @@ import (
 // TaskTypeUpdate is the builder for updating TaskType entities.
 type TaskTypeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TaskTypeMutation
+	hooks     []Hook
+	mutation  *TaskTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TaskTypeUpdate builder.
@@ -43,6 +45,85 @@ func (ttu *TaskTypeUpdate) SetNillableType(et *enum.TaskType) *TaskTypeUpdate {
 	return ttu
 }
 
+// SetTitle sets the "title" field.
+func (ttu *TaskTypeUpdate) SetTitle(s string) *TaskTypeUpdate {
+	ttu.mutation.SetTitle(s)
+	return ttu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ttu *TaskTypeUpdate) SetNillableTitle(s *string) *TaskTypeUpdate {
+	if s != nil {
+		ttu.SetTitle(*s)
+	}
+	return ttu
+}
+
+// SetPrompt sets the "prompt" field.
+func (ttu *TaskTypeUpdate) SetPrompt(m map[string]string) *TaskTypeUpdate {
+	ttu.mutation.SetPrompt(m)
+	return ttu
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (ttu *TaskTypeUpdate) ClearPrompt() *TaskTypeUpdate {
+	ttu.mutation.ClearPrompt()
+	return ttu
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (ttu *TaskTypeUpdate) SetPetTypes(s []string) *TaskTypeUpdate {
+	ttu.mutation.SetPetTypes(s)
+	return ttu
+}
+
+// AppendPetTypes appends s to the "pet_types" field.
+func (ttu *TaskTypeUpdate) AppendPetTypes(s []string) *TaskTypeUpdate {
+	ttu.mutation.AppendPetTypes(s)
+	return ttu
+}
+
+// ClearPetTypes clears the value of the "pet_types" field.
+func (ttu *TaskTypeUpdate) ClearPetTypes() *TaskTypeUpdate {
+	ttu.mutation.ClearPetTypes()
+	return ttu
+}
+
+// SetWeight sets the "weight" field.
+func (ttu *TaskTypeUpdate) SetWeight(i int) *TaskTypeUpdate {
+	ttu.mutation.ResetWeight()
+	ttu.mutation.SetWeight(i)
+	return ttu
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (ttu *TaskTypeUpdate) SetNillableWeight(i *int) *TaskTypeUpdate {
+	if i != nil {
+		ttu.SetWeight(*i)
+	}
+	return ttu
+}
+
+// AddWeight adds i to the "weight" field.
+func (ttu *TaskTypeUpdate) AddWeight(i int) *TaskTypeUpdate {
+	ttu.mutation.AddWeight(i)
+	return ttu
+}
+
+// SetActive sets the "active" field.
+func (ttu *TaskTypeUpdate) SetActive(b bool) *TaskTypeUpdate {
+	ttu.mutation.SetActive(b)
+	return ttu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (ttu *TaskTypeUpdate) SetNillableActive(b *bool) *TaskTypeUpdate {
+	if b != nil {
+		ttu.SetActive(*b)
+	}
+	return ttu
+}
+
 // SetTextFeature sets the "text_feature" field.
 func (ttu *TaskTypeUpdate) SetTextFeature(pg pgvector.Vector) *TaskTypeUpdate {
 	ttu.mutation.SetTextFeature(pg)
@@ -95,7 +176,26 @@ func (ttu *TaskTypeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ttu *TaskTypeUpdate) check() error {
+	if v, ok := ttu.mutation.Weight(); ok {
+		if err := tasktype.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "TaskType.weight": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttu *TaskTypeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskTypeUpdate {
+	ttu.modifiers = append(ttu.modifiers, modifiers...)
+	return ttu
+}
+
 func (ttu *TaskTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ttu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(tasktype.Table, tasktype.Columns, sqlgraph.NewFieldSpec(tasktype.FieldID, field.TypeInt))
 	if ps := ttu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -107,12 +207,42 @@ func (ttu *TaskTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ttu.mutation.GetType(); ok {
 		_spec.SetField(tasktype.FieldType, field.TypeString, value)
 	}
+	if value, ok := ttu.mutation.Title(); ok {
+		_spec.SetField(tasktype.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ttu.mutation.Prompt(); ok {
+		_spec.SetField(tasktype.FieldPrompt, field.TypeJSON, value)
+	}
+	if ttu.mutation.PromptCleared() {
+		_spec.ClearField(tasktype.FieldPrompt, field.TypeJSON)
+	}
+	if value, ok := ttu.mutation.PetTypes(); ok {
+		_spec.SetField(tasktype.FieldPetTypes, field.TypeJSON, value)
+	}
+	if value, ok := ttu.mutation.AppendedPetTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktype.FieldPetTypes, value)
+		})
+	}
+	if ttu.mutation.PetTypesCleared() {
+		_spec.ClearField(tasktype.FieldPetTypes, field.TypeJSON)
+	}
+	if value, ok := ttu.mutation.Weight(); ok {
+		_spec.SetField(tasktype.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.AddedWeight(); ok {
+		_spec.AddField(tasktype.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.Active(); ok {
+		_spec.SetField(tasktype.FieldActive, field.TypeBool, value)
+	}
 	if value, ok := ttu.mutation.TextFeature(); ok {
 		_spec.SetField(tasktype.FieldTextFeature, field.TypeOther, value)
 	}
 	if ttu.mutation.TextFeatureCleared() {
 		_spec.ClearField(tasktype.FieldTextFeature, field.TypeOther)
 	}
+	_spec.AddModifiers(ttu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tasktype.Label}
@@ -128,9 +258,10 @@ func (ttu *TaskTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TaskTypeUpdateOne is the builder for updating a single TaskType entity.
 type TaskTypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskTypeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetType sets the "type" field.
@@ -147,6 +278,85 @@ func (ttuo *TaskTypeUpdateOne) SetNillableType(et *enum.TaskType) *TaskTypeUpdat
 	return ttuo
 }
 
+// SetTitle sets the "title" field.
+func (ttuo *TaskTypeUpdateOne) SetTitle(s string) *TaskTypeUpdateOne {
+	ttuo.mutation.SetTitle(s)
+	return ttuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ttuo *TaskTypeUpdateOne) SetNillableTitle(s *string) *TaskTypeUpdateOne {
+	if s != nil {
+		ttuo.SetTitle(*s)
+	}
+	return ttuo
+}
+
+// SetPrompt sets the "prompt" field.
+func (ttuo *TaskTypeUpdateOne) SetPrompt(m map[string]string) *TaskTypeUpdateOne {
+	ttuo.mutation.SetPrompt(m)
+	return ttuo
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (ttuo *TaskTypeUpdateOne) ClearPrompt() *TaskTypeUpdateOne {
+	ttuo.mutation.ClearPrompt()
+	return ttuo
+}
+
+// SetPetTypes sets the "pet_types" field.
+func (ttuo *TaskTypeUpdateOne) SetPetTypes(s []string) *TaskTypeUpdateOne {
+	ttuo.mutation.SetPetTypes(s)
+	return ttuo
+}
+
+// AppendPetTypes appends s to the "pet_types" field.
+func (ttuo *TaskTypeUpdateOne) AppendPetTypes(s []string) *TaskTypeUpdateOne {
+	ttuo.mutation.AppendPetTypes(s)
+	return ttuo
+}
+
+// ClearPetTypes clears the value of the "pet_types" field.
+func (ttuo *TaskTypeUpdateOne) ClearPetTypes() *TaskTypeUpdateOne {
+	ttuo.mutation.ClearPetTypes()
+	return ttuo
+}
+
+// SetWeight sets the "weight" field.
+func (ttuo *TaskTypeUpdateOne) SetWeight(i int) *TaskTypeUpdateOne {
+	ttuo.mutation.ResetWeight()
+	ttuo.mutation.SetWeight(i)
+	return ttuo
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (ttuo *TaskTypeUpdateOne) SetNillableWeight(i *int) *TaskTypeUpdateOne {
+	if i != nil {
+		ttuo.SetWeight(*i)
+	}
+	return ttuo
+}
+
+// AddWeight adds i to the "weight" field.
+func (ttuo *TaskTypeUpdateOne) AddWeight(i int) *TaskTypeUpdateOne {
+	ttuo.mutation.AddWeight(i)
+	return ttuo
+}
+
+// SetActive sets the "active" field.
+func (ttuo *TaskTypeUpdateOne) SetActive(b bool) *TaskTypeUpdateOne {
+	ttuo.mutation.SetActive(b)
+	return ttuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (ttuo *TaskTypeUpdateOne) SetNillableActive(b *bool) *TaskTypeUpdateOne {
+	if b != nil {
+		ttuo.SetActive(*b)
+	}
+	return ttuo
+}
+
 // SetTextFeature sets the "text_feature" field.
 func (ttuo *TaskTypeUpdateOne) SetTextFeature(pg pgvector.Vector) *TaskTypeUpdateOne {
 	ttuo.mutation.SetTextFeature(pg)
@@ -212,7 +422,26 @@ func (ttuo *TaskTypeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ttuo *TaskTypeUpdateOne) check() error {
+	if v, ok := ttuo.mutation.Weight(); ok {
+		if err := tasktype.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "TaskType.weight": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttuo *TaskTypeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskTypeUpdateOne {
+	ttuo.modifiers = append(ttuo.modifiers, modifiers...)
+	return ttuo
+}
+
 func (ttuo *TaskTypeUpdateOne) sqlSave(ctx context.Context) (_node *TaskType, err error) {
+	if err := ttuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(tasktype.Table, tasktype.Columns, sqlgraph.NewFieldSpec(tasktype.FieldID, field.TypeInt))
 	id, ok := ttuo.mutation.ID()
 	if !ok {
@@ -241,12 +470,42 @@ func (ttuo *TaskTypeUpdateOne) sqlSave(ctx context.Context) (_node *TaskType, er
 	if value, ok := ttuo.mutation.GetType(); ok {
 		_spec.SetField(tasktype.FieldType, field.TypeString, value)
 	}
+	if value, ok := ttuo.mutation.Title(); ok {
+		_spec.SetField(tasktype.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ttuo.mutation.Prompt(); ok {
+		_spec.SetField(tasktype.FieldPrompt, field.TypeJSON, value)
+	}
+	if ttuo.mutation.PromptCleared() {
+		_spec.ClearField(tasktype.FieldPrompt, field.TypeJSON)
+	}
+	if value, ok := ttuo.mutation.PetTypes(); ok {
+		_spec.SetField(tasktype.FieldPetTypes, field.TypeJSON, value)
+	}
+	if value, ok := ttuo.mutation.AppendedPetTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktype.FieldPetTypes, value)
+		})
+	}
+	if ttuo.mutation.PetTypesCleared() {
+		_spec.ClearField(tasktype.FieldPetTypes, field.TypeJSON)
+	}
+	if value, ok := ttuo.mutation.Weight(); ok {
+		_spec.SetField(tasktype.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.AddedWeight(); ok {
+		_spec.AddField(tasktype.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.Active(); ok {
+		_spec.SetField(tasktype.FieldActive, field.TypeBool, value)
+	}
 	if value, ok := ttuo.mutation.TextFeature(); ok {
 		_spec.SetField(tasktype.FieldTextFeature, field.TypeOther, value)
 	}
 	if ttuo.mutation.TextFeatureCleared() {
 		_spec.ClearField(tasktype.FieldTextFeature, field.TypeOther)
 	}
+	_spec.AddModifiers(ttuo.modifiers...)
 	_node = &TaskType{config: ttuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
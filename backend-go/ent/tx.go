@@ -18,12 +18,22 @@ type Tx struct {
 	DailyTask *DailyTaskClient
 	// FollowRelation is the client for interacting with the FollowRelation builders.
 	FollowRelation *FollowRelationClient
+	// IndexCounter is the client for interacting with the IndexCounter builders.
+	IndexCounter *IndexCounterClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
+	// LocalAccount is the client for interacting with the LocalAccount builders.
+	LocalAccount *LocalAccountClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PushToken is the client for interacting with the PushToken builders.
+	PushToken *PushTokenClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// TaskType is the client for interacting with the TaskType builders.
 	TaskType *TaskTypeClient
 	// User is the client for interacting with the User builders.
@@ -162,9 +172,14 @@ func (tx *Tx) init() {
 	tx.Comment = NewCommentClient(tx.config)
 	tx.DailyTask = NewDailyTaskClient(tx.config)
 	tx.FollowRelation = NewFollowRelationClient(tx.config)
+	tx.IndexCounter = NewIndexCounterClient(tx.config)
 	tx.Like = NewLikeClient(tx.config)
+	tx.LocalAccount = NewLocalAccountClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PushToken = NewPushTokenClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.TaskType = NewTaskTypeClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,10 @@ type User struct {
 	Bio string `json:"bio,omitempty"`
 	// IconImageKey holds the value of the "icon_image_key" field.
 	IconImageKey string `json:"icon_image_key,omitempty"`
+	// IsAdmin holds the value of the "is_admin" field.
+	IsAdmin bool `json:"is_admin,omitempty"`
+	// PushOptOuts holds the value of the "push_opt_outs" field.
+	PushOptOuts []string `json:"push_opt_outs,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -52,9 +57,13 @@ type UserEdges struct {
 	Followers []*FollowRelation `json:"followers,omitempty"`
 	// DailyTasks holds the value of the daily_tasks edge.
 	DailyTasks []*DailyTask `json:"daily_tasks,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// PushTokens holds the value of the push_tokens edge.
+	PushTokens []*PushToken `json:"push_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [9]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -120,11 +129,33 @@ func (e UserEdges) DailyTasksOrErr() ([]*DailyTask, error) {
 	return nil, &NotLoadedError{edge: "daily_tasks"}
 }
 
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[7] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// PushTokensOrErr returns the PushTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PushTokensOrErr() ([]*PushToken, error) {
+	if e.loadedTypes[8] {
+		return e.PushTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "push_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldPushOptOuts:
+			values[i] = new([]byte)
+		case user.FieldIsAdmin:
+			values[i] = new(sql.NullBool)
 		case user.FieldIndex:
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldName, user.FieldBio, user.FieldIconImageKey:
@@ -184,6 +215,20 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.IconImageKey = value.String
 			}
+		case user.FieldIsAdmin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_admin", values[i])
+			} else if value.Valid {
+				u.IsAdmin = value.Bool
+			}
+		case user.FieldPushOptOuts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field push_opt_outs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.PushOptOuts); err != nil {
+					return fmt.Errorf("unmarshal field push_opt_outs: %w", err)
+				}
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -238,6 +283,16 @@ func (u *User) QueryDailyTasks() *DailyTaskQuery {
 	return NewUserClient(u.config).QueryDailyTasks(u)
 }
 
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotifications(u)
+}
+
+// QueryPushTokens queries the "push_tokens" edge of the User entity.
+func (u *User) QueryPushTokens() *PushTokenQuery {
+	return NewUserClient(u.config).QueryPushTokens(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -276,6 +331,12 @@ func (u *User) String() string {
 	builder.WriteString("icon_image_key=")
 	builder.WriteString(u.IconImageKey)
 	builder.WriteString(", ")
+	builder.WriteString("is_admin=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsAdmin))
+	builder.WriteString(", ")
+	builder.WriteString("push_opt_outs=")
+	builder.WriteString(fmt.Sprintf("%v", u.PushOptOuts))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -25,6 +25,10 @@ const (
 	FieldBio = "bio"
 	// FieldIconImageKey holds the string denoting the icon_image_key field in the database.
 	FieldIconImageKey = "icon_image_key"
+	// FieldIsAdmin holds the string denoting the is_admin field in the database.
+	FieldIsAdmin = "is_admin"
+	// FieldPushOptOuts holds the string denoting the push_opt_outs field in the database.
+	FieldPushOptOuts = "push_opt_outs"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
@@ -41,6 +45,10 @@ const (
 	EdgeFollowers = "followers"
 	// EdgeDailyTasks holds the string denoting the daily_tasks edge name in mutations.
 	EdgeDailyTasks = "daily_tasks"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgePushTokens holds the string denoting the push_tokens edge name in mutations.
+	EdgePushTokens = "push_tokens"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -92,6 +100,20 @@ const (
 	DailyTasksInverseTable = "daily_tasks"
 	// DailyTasksColumn is the table column denoting the daily_tasks relation/edge.
 	DailyTasksColumn = "user_daily_tasks"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_notifications"
+	// PushTokensTable is the table that holds the push_tokens relation/edge.
+	PushTokensTable = "push_tokens"
+	// PushTokensInverseTable is the table name for the PushToken entity.
+	// It exists in this package in order to avoid circular dependency with the "pushtoken" package.
+	PushTokensInverseTable = "push_tokens"
+	// PushTokensColumn is the table column denoting the push_tokens relation/edge.
+	PushTokensColumn = "user_push_tokens"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -102,6 +124,8 @@ var Columns = []string{
 	FieldName,
 	FieldBio,
 	FieldIconImageKey,
+	FieldIsAdmin,
+	FieldPushOptOuts,
 	FieldCreatedAt,
 }
 
@@ -124,6 +148,8 @@ var (
 	NameValidator func(string) error
 	// DefaultBio holds the default value on creation for the "bio" field.
 	DefaultBio string
+	// DefaultIsAdmin holds the default value on creation for the "is_admin" field.
+	DefaultIsAdmin bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -163,6 +189,11 @@ func ByIconImageKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIconImageKey, opts...).ToFunc()
 }
 
+// ByIsAdmin orders the results by the is_admin field.
+func ByIsAdmin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAdmin, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -265,6 +296,34 @@ func ByDailyTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDailyTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPushTokensCount orders the results by push_tokens count.
+func ByPushTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPushTokensStep(), opts...)
+	}
+}
+
+// ByPushTokens orders the results by push_tokens terms.
+func ByPushTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPushTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -314,3 +373,17 @@ func newDailyTasksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DailyTasksTable, DailyTasksColumn),
 	)
 }
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newPushTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PushTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PushTokensTable, PushTokensColumn),
+	)
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -84,6 +86,26 @@ func (uc *UserCreate) SetNillableIconImageKey(s *string) *UserCreate {
 	return uc
 }
 
+// SetIsAdmin sets the "is_admin" field.
+func (uc *UserCreate) SetIsAdmin(b bool) *UserCreate {
+	uc.mutation.SetIsAdmin(b)
+	return uc
+}
+
+// SetNillableIsAdmin sets the "is_admin" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsAdmin(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsAdmin(*b)
+	}
+	return uc
+}
+
+// SetPushOptOuts sets the "push_opt_outs" field.
+func (uc *UserCreate) SetPushOptOuts(s []string) *UserCreate {
+	uc.mutation.SetPushOptOuts(s)
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -217,6 +239,36 @@ func (uc *UserCreate) AddDailyTasks(d ...*DailyTask) *UserCreate {
 	return uc.AddDailyTaskIDs(ids...)
 }
 
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uc *UserCreate) AddNotificationIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddNotificationIDs(ids...)
+	return uc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uc *UserCreate) AddNotifications(n ...*Notification) *UserCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNotificationIDs(ids...)
+}
+
+// AddPushTokenIDs adds the "push_tokens" edge to the PushToken entity by IDs.
+func (uc *UserCreate) AddPushTokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddPushTokenIDs(ids...)
+	return uc
+}
+
+// AddPushTokens adds the "push_tokens" edges to the PushToken entity.
+func (uc *UserCreate) AddPushTokens(p ...*PushToken) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPushTokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -256,6 +308,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultBio
 		uc.mutation.SetBio(v)
 	}
+	if _, ok := uc.mutation.IsAdmin(); !ok {
+		v := user.DefaultIsAdmin
+		uc.mutation.SetIsAdmin(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -292,6 +348,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Bio(); !ok {
 		return &ValidationError{Name: "bio", err: errors.New(`ent: missing required field "User.bio"`)}
 	}
+	if _, ok := uc.mutation.IsAdmin(); !ok {
+		return &ValidationError{Name: "is_admin", err: errors.New(`ent: missing required field "User.is_admin"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -351,6 +410,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIconImageKey, field.TypeString, value)
 		_node.IconImageKey = value
 	}
+	if value, ok := uc.mutation.IsAdmin(); ok {
+		_spec.SetField(user.FieldIsAdmin, field.TypeBool, value)
+		_node.IsAdmin = value
+	}
+	if value, ok := uc.mutation.PushOptOuts(); ok {
+		_spec.SetField(user.FieldPushOptOuts, field.TypeJSON, value)
+		_node.PushOptOuts = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -467,6 +534,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PushTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PushTokensTable,
+			Columns: []string{user.PushTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -573,6 +672,36 @@ func (u *UserUpsert) ClearIconImageKey() *UserUpsert {
 	return u
 }
 
+// SetIsAdmin sets the "is_admin" field.
+func (u *UserUpsert) SetIsAdmin(v bool) *UserUpsert {
+	u.Set(user.FieldIsAdmin, v)
+	return u
+}
+
+// UpdateIsAdmin sets the "is_admin" field to the value that was provided on create.
+func (u *UserUpsert) UpdateIsAdmin() *UserUpsert {
+	u.SetExcluded(user.FieldIsAdmin)
+	return u
+}
+
+// SetPushOptOuts sets the "push_opt_outs" field.
+func (u *UserUpsert) SetPushOptOuts(v []string) *UserUpsert {
+	u.Set(user.FieldPushOptOuts, v)
+	return u
+}
+
+// UpdatePushOptOuts sets the "push_opt_outs" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePushOptOuts() *UserUpsert {
+	u.SetExcluded(user.FieldPushOptOuts)
+	return u
+}
+
+// ClearPushOptOuts clears the value of the "push_opt_outs" field.
+func (u *UserUpsert) ClearPushOptOuts() *UserUpsert {
+	u.SetNull(user.FieldPushOptOuts)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserUpsert) SetCreatedAt(v time.Time) *UserUpsert {
 	u.Set(user.FieldCreatedAt, v)
@@ -699,6 +828,41 @@ func (u *UserUpsertOne) ClearIconImageKey() *UserUpsertOne {
 	})
 }
 
+// SetIsAdmin sets the "is_admin" field.
+func (u *UserUpsertOne) SetIsAdmin(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsAdmin(v)
+	})
+}
+
+// UpdateIsAdmin sets the "is_admin" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateIsAdmin() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsAdmin()
+	})
+}
+
+// SetPushOptOuts sets the "push_opt_outs" field.
+func (u *UserUpsertOne) SetPushOptOuts(v []string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPushOptOuts(v)
+	})
+}
+
+// UpdatePushOptOuts sets the "push_opt_outs" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePushOptOuts() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePushOptOuts()
+	})
+}
+
+// ClearPushOptOuts clears the value of the "push_opt_outs" field.
+func (u *UserUpsertOne) ClearPushOptOuts() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPushOptOuts()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserUpsertOne) SetCreatedAt(v time.Time) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -994,6 +1158,41 @@ func (u *UserUpsertBulk) ClearIconImageKey() *UserUpsertBulk {
 	})
 }
 
+// SetIsAdmin sets the "is_admin" field.
+func (u *UserUpsertBulk) SetIsAdmin(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsAdmin(v)
+	})
+}
+
+// UpdateIsAdmin sets the "is_admin" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateIsAdmin() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsAdmin()
+	})
+}
+
+// SetPushOptOuts sets the "push_opt_outs" field.
+func (u *UserUpsertBulk) SetPushOptOuts(v []string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPushOptOuts(v)
+	})
+}
+
+// UpdatePushOptOuts sets the "push_opt_outs" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePushOptOuts() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePushOptOuts()
+	})
+}
+
+// ClearPushOptOuts clears the value of the "push_opt_outs" field.
+func (u *UserUpsertBulk) ClearPushOptOuts() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPushOptOuts()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserUpsertBulk) SetCreatedAt(v time.Time) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -5,18 +5,51 @@ import (
 	"github.com/google/uuid"
 )
 
+// TokenPair is the set of tokens issued by the auth provider on sign in or refresh
+type TokenPair struct {
+	AccessToken string
+	IdToken     string
+	// RefreshToken is empty when refreshing, since the refresh token stays the same
+	RefreshToken string
+	// ExpiresIn is the lifetime of the access and ID tokens in seconds
+	ExpiresIn int32
+}
+
 type RefreshTokenResponse struct {
 	AccessToken string
 	IdToken     string
 }
 
+// UserBaseResponse is the author shown on posts and comments
 type UserBaseResponse struct {
 	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
 	Name         string    `json:"name"`
 	IconImageUrl string    `json:"iconImageUrl"`
 }
 
+// PublicUserResponse is a user as shown to other users, without private fields such as the email
+type PublicUserResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Bio          string    `json:"bio"`
+	IconImageUrl string    `json:"iconImageUrl"`
+}
+
+// FollowUserResponse is an entry of a follower or following list
+type FollowUserResponse struct {
+	PublicUserResponse
+	FollowedByMe bool `json:"followedByMe"`
+}
+
+// UserProfileResponse is the profile of a user as shown to other users
+type UserProfileResponse struct {
+	PublicUserResponse
+	FollowerCount  int            `json:"followerCount"`
+	FollowingCount int            `json:"followingCount"`
+	Posts          []PostResponse `json:"posts"`
+	Pets           []PetResponse  `json:"pets"`
+}
+
 type UserResponse struct {
 	ID           uuid.UUID      `json:"id"`
 	Email        string         `json:"email"`
@@ -27,10 +60,11 @@ type UserResponse struct {
 	Pets         []PetResponse  `json:"pets"`
 }
 
-// NewPetResponse converts a Pet to a PetResponse
+// NewUserResponse converts a User to the UserResponse shown to the user themselves
 func NewUserResponse(user *ent.User, imageURL string, posts []PostResponse, pets []PetResponse) UserResponse {
 	return UserResponse{
 		ID:           user.ID,
+		Email:        user.Email,
 		Name:         user.Name,
 		Bio:          user.Bio,
 		IconImageUrl: imageURL,
@@ -39,11 +73,25 @@ func NewUserResponse(user *ent.User, imageURL string, posts []PostResponse, pets
 	}
 }
 
+func NewPublicUserResponse(user *ent.User, imageURL string) PublicUserResponse {
+	return PublicUserResponse{
+		ID:           user.ID,
+		Name:         user.Name,
+		Bio:          user.Bio,
+		IconImageUrl: imageURL,
+	}
+}
+
 func NewUserBaseResponse(user *ent.User, imageURL string) UserBaseResponse {
 	return UserBaseResponse{
 		ID:           user.ID,
-		Email:        user.Email,
 		Name:         user.Name,
 		IconImageUrl: imageURL,
 	}
 }
+
+// RelationshipResponse describes the follow state between the signed-in user and another user
+type RelationshipResponse struct {
+	Following  bool `json:"following"`
+	FollowedBy bool `json:"followedBy"`
+}
@@ -1,24 +1,61 @@
 package models
 
 import (
+	"errors"
 	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/google/uuid"
 )
 
+// MaxCommentLength is the longest comment accepted, in characters
+const MaxCommentLength = 1000
+
+var (
+	// ErrInvalidCommentContent is returned for a comment that is blank or longer than MaxCommentLength
+	ErrInvalidCommentContent = errors.New("invalid comment content")
+	// ErrInvalidCommentParent is returned when replying to a comment of another post, or to a deleted comment
+	ErrInvalidCommentParent = errors.New("invalid parent comment")
+)
+
+// CommentDeletion is what deleting a comment did to its thread
+type CommentDeletion struct {
+	// Placeholder is the comment kept without content because it still has replies
+	Placeholder *ent.Comment
+	// RemovedParentID is the placeholder removed together with its last reply
+	RemovedParentID *uuid.UUID
+}
+
+// CommentResponse is a comment, or the placeholder of a deleted comment that still has replies.
+// User is null and Content is empty for placeholders. User is also null for a new comment
+// whose user could not be loaded after it was saved.
 type CommentResponse struct {
-	ID        uuid.UUID        `json:"id"`
-	Content   string           `json:"content"`
-	CreatedAt time.Time        `json:"createdAt"`
-	User      UserBaseResponse `json:"user"`
+	ID         uuid.UUID         `json:"id"`
+	ParentID   *uuid.UUID        `json:"parentId"`
+	Content    string            `json:"content"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	EditedAt   *time.Time        `json:"editedAt"`
+	Deleted    bool              `json:"deleted"`
+	ReplyCount int               `json:"replyCount"`
+	User       *UserBaseResponse `json:"user"`
 }
 
-func NewCommentResponse(comment *ent.Comment, user *ent.User, userImageURL string) CommentResponse {
-	return CommentResponse{
-		ID:        comment.ID,
-		Content:   comment.Content,
-		CreatedAt: comment.CreatedAt,
-		User:      NewUserBaseResponse(user, userImageURL),
+// NewCommentResponse takes a comment loaded with its user and, for replies, its parent
+func NewCommentResponse(comment *ent.Comment, userImageURL string, replyCount int) CommentResponse {
+	resp := CommentResponse{
+		ID:         comment.ID,
+		Content:    comment.Content,
+		CreatedAt:  comment.CreatedAt,
+		EditedAt:   comment.EditedAt,
+		Deleted:    comment.DeletedAt != nil,
+		ReplyCount: replyCount,
+	}
+	if parent := comment.Edges.Parent; parent != nil {
+		resp.ParentID = &parent.ID
+	}
+	if !resp.Deleted && comment.Edges.User != nil {
+		user := NewUserBaseResponse(comment.Edges.User, userImageURL)
+		resp.User = &user
 	}
+	return resp
 }
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := uuid.MustParse("0b5c6f4e-7a8d-4c1e-9f2a-3d4e5f607182")
+	tests := []struct {
+		name      string
+		createdAt time.Time
+	}{
+		{"utc", time.Date(2025, 4, 1, 12, 30, 0, 0, time.UTC)},
+		{"nanoseconds", time.Date(2025, 4, 1, 12, 30, 0, 123456789, time.UTC)},
+		{"other zone", time.Date(2025, 4, 1, 21, 30, 0, 0, time.FixedZone("JST", 9*60*60))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := Cursor{CreatedAt: tt.createdAt, ID: id}.Encode()
+			decoded, err := DecodeCursor(encoded)
+			if err != nil {
+				t.Fatalf("DecodeCursor(%q) returned error: %v", encoded, err)
+			}
+			if !decoded.CreatedAt.Equal(tt.createdAt) {
+				t.Errorf("CreatedAt = %v, want %v", decoded.CreatedAt, tt.createdAt)
+			}
+			if decoded.ID != id {
+				t.Errorf("ID = %v, want %v", decoded.ID, id)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorEmpty(t *testing.T) {
+	cursor, err := DecodeCursor("")
+	if err != nil || cursor != nil {
+		t.Errorf("DecodeCursor(\"\") = %v, %v, want nil, nil", cursor, err)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "!!!"},
+		{"no separator", encode("2025-04-01T12:30:00Z")},
+		{"bad time", encode("yesterday|0b5c6f4e-7a8d-4c1e-9f2a-3d4e5f607182")},
+		{"bad id", encode("2025-04-01T12:30:00Z|not-a-uuid")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeCursor(%q) error = %v, want ErrInvalidCursor", tt.cursor, err)
+			}
+		})
+	}
+}
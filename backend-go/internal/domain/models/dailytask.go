@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDailyTaskNotToday is returned when a post is linked to a task of a past day
+	ErrDailyTaskNotToday = errors.New("daily task is not today's task")
+	// ErrDailyTaskCompleted is returned when a post is linked to a task that already has a post
+	ErrDailyTaskCompleted = errors.New("daily task is already completed")
+)
+
+// DefaultTaskScoreThreshold is the task score a post needs to complete its daily task
+const DefaultTaskScoreThreshold = 25.0
+
+// TaskResult is the outcome of scoring a post against its daily task
+type TaskResult struct {
+	// Score is nil when the scoring service could not rate the post
+	Score     *float64 `json:"score"`
+	Completed bool     `json:"completed"`
+}
+
+// taskDayLocation is the time zone in which daily tasks roll over (00:00 JST)
+var taskDayLocation = time.FixedZone("JST", 9*60*60)
+
+// StartOfTaskDay returns the start of the task day that contains t
+func StartOfTaskDay(t time.Time) time.Time {
+	local := t.In(taskDayLocation)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, taskDayLocation)
+}
+
+type DailyTaskResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	Type      enum.TaskType `json:"type"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+func NewDailyTaskResponse(dailyTask *ent.DailyTask) DailyTaskResponse {
+	return DailyTaskResponse{
+		ID:        dailyTask.ID,
+		Type:      dailyTask.Type,
+		CreatedAt: dailyTask.CreatedAt,
+	}
+}
+
+// DailyTaskStatusResponse is a task of the signed-in user with its completion state
+type DailyTaskStatusResponse struct {
+	DailyTaskResponse
+	Title     string     `json:"title"`
+	Prompt    string     `json:"prompt"`
+	Completed bool       `json:"completed"`
+	PostID    *uuid.UUID `json:"postId"`
+}
+
+// NewDailyTaskStatusResponse converts a DailyTask whose post edge is loaded
+func NewDailyTaskStatusResponse(dailyTask *ent.DailyTask) DailyTaskStatusResponse {
+	var postID *uuid.UUID
+	if dailyTask.Edges.Post != nil {
+		postID = &dailyTask.Edges.Post.ID
+	}
+	return DailyTaskStatusResponse{
+		DailyTaskResponse: NewDailyTaskResponse(dailyTask),
+		Completed:         postID != nil,
+		PostID:            postID,
+	}
+}
+
+// Describe fills the title and the prompt in the given language from the catalog entry of the task.
+// taskType may be nil when the entry has been removed from the catalog.
+func (r *DailyTaskStatusResponse) Describe(taskType *ent.TaskType, lang string) {
+	if taskType == nil {
+		return
+	}
+	r.Title = taskType.Title
+	r.Prompt = LocalizedPrompt(taskType.Prompt, lang)
+}
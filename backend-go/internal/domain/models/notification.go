@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/google/uuid"
+)
+
+// NotificationResponse is a notification, possibly collapsing the same event by several users
+type NotificationResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Kind string    `json:"kind"`
+	// Actor is the user who acted last
+	Actor UserBaseResponse `json:"actor"`
+	// OtherActorCount is the number of other users, as in "X and 12 others liked your post"
+	OtherActorCount  int        `json:"otherActorCount"`
+	PostID           *uuid.UUID `json:"postId"`
+	PostThumbnailURL string     `json:"postThumbnailUrl,omitempty"`
+	Read             bool       `json:"read"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+func NewNotificationResponse(n *ent.Notification, actorImageURL, postThumbnailURL string) NotificationResponse {
+	resp := NotificationResponse{
+		ID:               n.ID,
+		Kind:             n.Kind.String(),
+		Actor:            NewUserBaseResponse(n.Edges.Actor, actorImageURL),
+		OtherActorCount:  max(len(n.ActorIds)-1, 0),
+		PostThumbnailURL: postThumbnailURL,
+		Read:             n.Read,
+		UpdatedAt:        n.UpdatedAt,
+	}
+	if n.Edges.Post != nil {
+		resp.PostID = &n.Edges.Post.ID
+	}
+	return resp
+}
+
+// NotificationEvent is a recorded notification handed to the push delivery worker
+type NotificationEvent struct {
+	Kind        notification.Kind
+	ActorID     string
+	RecipientID string
+	// PostID is empty for follows
+	PostID string
+}
@@ -8,20 +8,51 @@ import (
 )
 
 type PostResponse struct {
-	ID        uuid.UUID        `json:"id"`
-	Caption   string           `json:"caption"`
-	User      UserBaseResponse `json:"user"`
-	ImageURL  string           `json:"imageUrl"`
-	CreatedAt time.Time        `json:"createdAt"`
+	ID           uuid.UUID          `json:"id"`
+	Caption      string             `json:"caption"`
+	User         UserBaseResponse   `json:"user"`
+	ImageURL     string             `json:"imageUrl"`
+	ThumbnailURL string             `json:"thumbnailUrl"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	LikeCount    int                `json:"likeCount"`
+	CommentCount int                `json:"commentCount"`
+	LikedByMe    bool               `json:"likedByMe"`
+	DailyTask    *DailyTaskResponse `json:"dailyTask"`
 }
 
-func NewPostResponse(post *ent.Post, postImageURL string, userImageURL string) PostResponse {
+// PostStats holds the aggregated counts of a post and the state of the viewer
+type PostStats struct {
+	LikeCount    int
+	CommentCount int
+	LikedByMe    bool
+}
+
+func NewPostResponse(post *ent.Post, postImageURL, postThumbnailURL, userImageURL string, stats PostStats) PostResponse {
 	user := post.Edges.User
+	var dailyTask *DailyTaskResponse
+	if post.Edges.DailyTask != nil {
+		resp := NewDailyTaskResponse(post.Edges.DailyTask)
+		dailyTask = &resp
+	}
 	return PostResponse{
-		ID:        post.ID,
-		Caption:   post.Caption,
-		User:      NewUserBaseResponse(user, userImageURL),
-		ImageURL:  postImageURL,
-		CreatedAt: post.CreatedAt,
+		ID:           post.ID,
+		Caption:      post.Caption,
+		User:         NewUserBaseResponse(user, userImageURL),
+		ImageURL:     postImageURL,
+		ThumbnailURL: postThumbnailURL,
+		CreatedAt:    post.CreatedAt,
+		LikeCount:    stats.LikeCount,
+		CommentCount: stats.CommentCount,
+		LikedByMe:    stats.LikedByMe,
+		DailyTask:    dailyTask,
 	}
 }
+
+// RecommendedTimeline is one page of the recommended timeline.
+// When the recommendation service cannot be used the latest posts are served and paged by NextCursor instead of NextOffset.
+type RecommendedTimeline struct {
+	Posts       []PostResponse
+	Recommended bool
+	NextOffset  *int
+	NextCursor  *Cursor
+}
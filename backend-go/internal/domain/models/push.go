@@ -0,0 +1,22 @@
+package models
+
+import "errors"
+
+var (
+	// ErrInvalidPushToken is returned when a device registers something that is not an Expo push token
+	ErrInvalidPushToken = errors.New("invalid push token")
+	// ErrInvalidPushPreference is returned for a preference of an unknown notification kind
+	ErrInvalidPushPreference = errors.New("invalid push preference")
+)
+
+// PushMessage is a push notification to a single device
+type PushMessage struct {
+	Token string
+	Title string
+	Body  string
+	// Data is handed to the app when the notification is opened
+	Data map[string]string
+}
+
+// PushPreferences tells for each notification kind whether it is sent as a push notification
+type PushPreferences map[string]bool
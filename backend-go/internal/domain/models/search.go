@@ -0,0 +1,6 @@
+package models
+
+import "errors"
+
+// ErrEmbeddingNotConfigured is returned by the embedding repository when no embedding service is configured
+var ErrEmbeddingNotConfigured = errors.New("embedding service is not configured")
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of StreamEvent
+const (
+	StreamEventCommentCreated = "comment-created"
+	StreamEventCommentUpdated = "comment-updated"
+	StreamEventCommentDeleted = "comment-deleted"
+	StreamEventLikeCount      = "like-count"
+	StreamEventNotification   = "notification"
+)
+
+// StreamEvent is pushed to the clients watching a channel.
+// Data is already encoded, so that the event can be relayed between API instances as is.
+type StreamEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// PostChannel carries the comment and like events of a post
+func PostChannel(postId string) string {
+	return "post:" + postId
+}
+
+// UserChannel carries the notifications of a user
+func UserChannel(userId string) string {
+	return "user:" + userId
+}
+
+type CommentDeletedEvent struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type LikeCountEvent struct {
+	PostID uuid.UUID `json:"postId"`
+	Count  int       `json:"count"`
+}
+
+// NotificationStreamEvent tells the client to refetch its notifications
+type NotificationStreamEvent struct {
+	Kind        string `json:"kind"`
+	ActorID     string `json:"actorId"`
+	PostID      string `json:"postId,omitempty"`
+	UnreadCount int    `json:"unreadCount"`
+}
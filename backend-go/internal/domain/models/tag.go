@@ -0,0 +1,64 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxTagLength is the longest hashtag kept, in characters. Longer ones are ignored.
+	MaxTagLength = 64
+	// MaxTagsPerPost is how many hashtags of a caption are kept
+	MaxTagsPerPost = 30
+)
+
+// ErrInvalidTag is returned for a tag name that could not appear as a hashtag
+var ErrInvalidTag = errors.New("invalid tag")
+
+var (
+	// A hashtag starts with # or the full-width ＃ that is not preceded by a word character, as in "#柴犬" or "＃catnap"
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{M}\p{N}_])[#＃]([\p{L}\p{M}\p{N}_]+)`)
+	tagNamePattern = regexp.MustCompile(`^[\p{L}\p{M}\p{N}_]+$`)
+)
+
+// NormalizeTag converts a tag to the form it is stored in, so that "#CatNap", "catnap" and "ｃａｔｎａｐ" are the same tag.
+// A leading # is removed.
+func NormalizeTag(name string) (string, error) {
+	name = strings.ToLower(norm.NFKC.String(strings.TrimSpace(name)))
+	name = strings.TrimPrefix(name, "#")
+	if !tagNamePattern.MatchString(name) || utf8.RuneCountInString(name) > MaxTagLength {
+		return "", ErrInvalidTag
+	}
+	return name, nil
+}
+
+// ParseHashtags returns the normalized hashtags of a caption in order of appearance, without duplicates
+func ParseHashtags(caption string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, match := range hashtagPattern.FindAllStringSubmatch(caption, -1) {
+		tag, err := NormalizeTag(match[1])
+		if err != nil || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+		if len(tags) == MaxTagsPerPost {
+			break
+		}
+	}
+	return tags
+}
+
+// TrendingTag is a tag ranked by its recent use
+type TrendingTag struct {
+	Name string `json:"name"`
+	// PostCount is the number of posts with the tag in the trending window
+	PostCount int `json:"postCount"`
+	// Score sums the posts with the tag, each weighted by half every half-life since it was posted
+	Score float64 `json:"score"`
+}
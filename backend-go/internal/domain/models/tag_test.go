@@ -0,0 +1,88 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    string
+		wantErr bool
+	}{
+		{"lower case", "catnap", "catnap", false},
+		{"upper case", "CatNap", "catnap", false},
+		{"leading hash", "#catnap", "catnap", false},
+		{"surrounding spaces", "  catnap ", "catnap", false},
+		{"full-width letters", "ｃａｔｎａｐ", "catnap", false},
+		{"full-width digits", "ねこ２０２４", "ねこ2024", false},
+		{"half-width katakana", "ﾈｺ", "ネコ", false},
+		{"japanese", "柴犬", "柴犬", false},
+		{"underscore", "cat_nap", "cat_nap", false},
+		{"combining dakuten is composed", "が", "が", false},
+		{"longest", strings.Repeat("a", MaxTagLength), strings.Repeat("a", MaxTagLength), false},
+		{"too long", strings.Repeat("a", MaxTagLength+1), "", true},
+		{"empty", "", "", true},
+		{"hash only", "#", "", true},
+		{"space inside", "cat nap", "", true},
+		{"punctuation", "cat-nap", "", true},
+		{"two hashes", "##catnap", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTag(tt.tag)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidTag) {
+					t.Errorf("NormalizeTag(%q) = %q, %v, want ErrInvalidTag", tt.tag, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("NormalizeTag(%q) = %q, %v, want %q", tt.tag, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHashtags(t *testing.T) {
+	manyTags := make([]string, MaxTagsPerPost+5)
+	for i := range manyTags {
+		manyTags[i] = fmt.Sprintf("#tag%d", i)
+	}
+	wantManyTags := make([]string, MaxTagsPerPost)
+	for i := range wantManyTags {
+		wantManyTags[i] = fmt.Sprintf("tag%d", i)
+	}
+
+	tests := []struct {
+		name    string
+		caption string
+		want    []string
+	}{
+		{"no hashtags", "お昼寝中", nil},
+		{"ascii hash", "お昼寝中 #catnap", []string{"catnap"}},
+		{"full-width hash", "お昼寝中 ＃柴犬", []string{"柴犬"}},
+		{"at the start", "#dog in the park", []string{"dog"}},
+		{"order of appearance", "#b #a #c", []string{"b", "a", "c"}},
+		{"adjacent hashtags", "#dog#cat", []string{"dog"}},
+		{"after japanese punctuation", "かわいい。#ねこ、＃いぬ", []string{"ねこ", "いぬ"}},
+		{"duplicates after normalization", "#CatNap #catnap ＃ｃａｔｎａｐ", []string{"catnap"}},
+		{"not preceded by a word character", "email#tag a_#tag", nil},
+		{"ends at punctuation", "#dog! #cat.", []string{"dog", "cat"}},
+		{"hash only", "# #", nil},
+		{"too long is skipped", "#" + strings.Repeat("a", MaxTagLength+1) + " #ok", []string{"ok"}},
+		{"at most MaxTagsPerPost", strings.Join(manyTags, " "), wantManyTags},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseHashtags(tt.caption); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseHashtags(%q) = %q, want %q", tt.caption, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+)
+
+// DefaultLanguage is used when a prompt has no text for the requested language
+const DefaultLanguage = "ja"
+
+// TaskTypeInput is the editable part of a task in the catalog
+type TaskTypeInput struct {
+	Type     enum.TaskType     `json:"type"`
+	Title    string            `json:"title"`
+	Prompt   map[string]string `json:"prompt"`
+	PetTypes []string          `json:"petTypes"`
+	Weight   int               `json:"weight"`
+	Active   bool              `json:"active"`
+}
+
+type TaskTypeResponse struct {
+	ID       int               `json:"id"`
+	Type     enum.TaskType     `json:"type"`
+	Title    string            `json:"title"`
+	Prompt   map[string]string `json:"prompt"`
+	PetTypes []string          `json:"petTypes"`
+	Weight   int               `json:"weight"`
+	Active   bool              `json:"active"`
+}
+
+func NewTaskTypeResponse(taskType *ent.TaskType) TaskTypeResponse {
+	return TaskTypeResponse{
+		ID:       taskType.ID,
+		Type:     taskType.Type,
+		Title:    taskType.Title,
+		Prompt:   taskType.Prompt,
+		PetTypes: taskType.PetTypes,
+		Weight:   taskType.Weight,
+		Active:   taskType.Active,
+	}
+}
+
+// LocalizedPrompt returns the prompt in the given language, falling back to the default language
+func LocalizedPrompt(prompt map[string]string, lang string) string {
+	if text, ok := prompt[lang]; ok {
+		return text
+	}
+	return prompt[DefaultLanguage]
+}
+
+// AppliesToPets reports whether the task suits a user with the given pet types.
+// A task without pet types applies to everyone.
+func AppliesToPets(taskType *ent.TaskType, petTypes map[string]bool) bool {
+	if len(taskType.PetTypes) == 0 {
+		return true
+	}
+	for _, petType := range taskType.PetTypes {
+		if petTypes[petType] {
+			return true
+		}
+	}
+	return false
+}
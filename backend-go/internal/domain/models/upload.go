@@ -0,0 +1,116 @@
+package models
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+)
+
+// MaxImageSize is the largest image accepted for posts, pets and profiles
+const MaxImageSize = 10 << 20
+
+var (
+	ErrInvalidUploadDirectory = errors.New("invalid upload directory")
+	ErrUnsupportedImageType   = errors.New("unsupported image type")
+	// ErrUploadNotOwned is returned when a key issued to another user is submitted
+	ErrUploadNotOwned = errors.New("upload key does not belong to the user")
+	// ErrUploadNotFound is returned when nothing has been uploaded under the key
+	ErrUploadNotFound = errors.New("uploaded image not found")
+	ErrUploadTooLarge = errors.New("uploaded image is too large")
+	// ErrUploadAlreadyUsed is returned when the uploaded image has already been attached to a post, pet or user
+	ErrUploadAlreadyUsed = errors.New("uploaded image is already in use")
+	// ErrInvalidFileSignature is returned when a URL of the local storage is expired or was not issued by the API
+	ErrInvalidFileSignature = errors.New("invalid file URL signature")
+)
+
+// UploadDirectories are the directories clients may upload images to
+var UploadDirectories = map[string]bool{
+	"posts":   true,
+	"pets":    true,
+	"profile": true,
+}
+
+// ImageExtensions maps the accepted image content types to the extension of their keys
+var ImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/heic": ".heic",
+}
+
+// ImageVariant is a downscaled copy stored next to the original image
+type ImageVariant struct {
+	Name string
+	// MaxSize is the length of the longer side in pixels
+	MaxSize int
+}
+
+var (
+	ThumbnailVariant = ImageVariant{Name: "thumbnail", MaxSize: 320}
+	MediumVariant    = ImageVariant{Name: "medium", MaxSize: 1080}
+	ImageVariants    = []ImageVariant{ThumbnailVariant, MediumVariant}
+)
+
+// Processed images share a base key: <directory>/<userId>/<uuid>/original.jpg, thumbnail.jpg and medium.jpg.
+// Images stored before processing was introduced have a single key and no variants.
+
+// UploadImageKey is the key a client uploads the unprocessed image to with a presigned URL
+func UploadImageKey(baseKey, extension string) string {
+	return baseKey + "/upload" + extension
+}
+
+func OriginalImageKey(baseKey, extension string) string {
+	return baseKey + "/original" + extension
+}
+
+func VariantImageKey(baseKey string, variant ImageVariant) string {
+	return baseKey + "/" + variant.Name + ".jpg"
+}
+
+// ImageBaseKey returns the base key shared by the file and its variants,
+// or false when the key does not follow the processed layout
+func ImageBaseKey(fileKey string) (string, bool) {
+	dir, file := path.Split(fileKey)
+	if dir == "" || (!strings.HasPrefix(file, "original.") && !strings.HasPrefix(file, "upload.")) {
+		return "", false
+	}
+	return strings.TrimSuffix(dir, "/"), true
+}
+
+// ThumbnailKey returns the key of the thumbnail of an image, falling back to the image itself when it has none
+func ThumbnailKey(fileKey string) string {
+	baseKey, ok := ImageBaseKey(fileKey)
+	if !ok {
+		return fileKey
+	}
+	return VariantImageKey(baseKey, ThumbnailVariant)
+}
+
+// ImageKeys returns the keys of the image and all of its variants
+func ImageKeys(fileKey string) []string {
+	keys := []string{fileKey}
+	baseKey, ok := ImageBaseKey(fileKey)
+	if !ok {
+		return keys
+	}
+	for _, variant := range ImageVariants {
+		keys = append(keys, VariantImageKey(baseKey, variant))
+	}
+	return keys
+}
+
+// StoredObject is the metadata of an object in storage
+type StoredObject struct {
+	ContentType string
+	Size        int64
+}
+
+// UploadResponse tells the client where to PUT the image and which key to submit afterwards
+type UploadResponse struct {
+	Key         string    `json:"key"`
+	UploadURL   string    `json:"uploadUrl"`
+	ContentType string    `json:"contentType"`
+	MaxSize     int64     `json:"maxSize"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
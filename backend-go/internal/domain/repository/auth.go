@@ -1,16 +1,18 @@
 package repository
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 )
 
 type AuthRepository interface {
-	GenerateHash(username string) string
-	VerifyToken(email, token string) error
+	// VerifyToken verifies an ID or access token and returns the email of its owner
+	VerifyToken(token string) (string, error)
 	CreateUser(name, email, password string) error
 	VerifyEmail(email, code string) error
-	SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error)
-	RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error)
+	SignIn(email, password string) (*models.TokenPair, error)
+	RefreshToken(refreshToken string) (*models.TokenPair, error)
 	GetUserEmail(accessToken string) (string, error)
-	SignOut(token string) error
+	SignOut(accessToken string) error
+	// PublicKeySet returns the JSON Web Key Set the tokens are verified with
+	PublicKeySet() ([]byte, error)
 }
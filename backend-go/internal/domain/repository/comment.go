@@ -2,11 +2,23 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
 )
 
 type CommentRepository interface {
-	Create(userId string, postId string, content string) error
-	Delete(commentId string) error
+	// Create adds a top-level comment when parentId is empty, and a reply otherwise
+	Create(userId string, postId string, parentId string, content string) (*ent.Comment, error)
+	// GetById returns the comment with its user, post and parent. Deleted comments are not found.
+	GetById(commentId string) (*ent.Comment, error)
+	Update(commentId string, content string) error
+	// Delete leaves a placeholder when the comment still has replies, and removes a placeholder left without replies
+	Delete(commentId string) (models.CommentDeletion, error)
 	Count(postId string) (int, error)
-	GetByPostId(postId string) ([]*ent.Comment, error)
+	CountByPosts(postIds []uuid.UUID) (map[uuid.UUID]int, error)
+	CountReplies(commentIds []uuid.UUID) (map[uuid.UUID]int, error)
+	// ListTopLevel returns the top-level comments of the post oldest first, starting after the cursor
+	ListTopLevel(postId string, cursor *models.Cursor, limit int) ([]*ent.Comment, error)
+	// ListReplies returns the replies to the comment oldest first, starting after the cursor
+	ListReplies(commentId string, cursor *models.Cursor, limit int) ([]*ent.Comment, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+type DailyTaskRepository interface {
+	GetById(dailyTaskId string) (*ent.DailyTask, error)
+	GetLatestByUser(userId string, since time.Time) (*ent.DailyTask, error)
+	GetByUser(userId string, cursor *models.Cursor, limit int) ([]*ent.DailyTask, error)
+	GetCompletedDates(userId string, since time.Time) ([]time.Time, error)
+}
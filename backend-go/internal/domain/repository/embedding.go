@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/pgvector/pgvector-go"
+
+// EmbeddingRepository maps text into the same vector space as Post.image_feature
+type EmbeddingRepository interface {
+	EmbedText(text string) (pgvector.Vector, error)
+}
@@ -1,10 +1,16 @@
 package repository
 
-import "github.com/aki-13627/animalia/backend-go/ent"
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
 
 type FollowRelationRepository interface {
 	CountFollows(userId string) (int, error)
 	CountFollowers(userId string) (int, error)
-	Followings(userId string) ([]*ent.User, error)
-	Followers(userId string) ([]*ent.User, error)
+	Followings(userId string, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error)
+	Followers(userId string, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error)
+	IsFollowing(fromId string, toId string) (bool, error)
+	FollowingIds(fromId string, toIds []uuid.UUID) (map[uuid.UUID]bool, error)
 }
@@ -1,7 +1,11 @@
 package repository
 
+import "github.com/google/uuid"
+
 type LikeRepository interface {
 	Create(userId string, postId string) error
 	Delete(userId string, postId string) error
 	Count(petID string) (int, error)
+	CountByPosts(postIds []uuid.UUID) (map[uuid.UUID]int, error)
+	LikedPostIds(userId string, postIds []uuid.UUID) (map[uuid.UUID]bool, error)
 }
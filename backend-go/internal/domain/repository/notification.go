@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+type NotificationRepository interface {
+	// Record adds the actor to the unread notification of the same kind and post, or creates one.
+	// postId is empty for follows.
+	Record(kind notification.Kind, actorId, recipientId, postId string) error
+	// List returns the notifications of the user, most recently updated first, with the actor and post loaded
+	List(recipientId string, cursor *models.Cursor, limit int) ([]*ent.Notification, error)
+	CountUnread(recipientId string) (int, error)
+	// MarkRead returns a not found error when the notification does not belong to the user
+	MarkRead(recipientId, notificationId string) error
+	MarkAllRead(recipientId string) error
+}
@@ -5,6 +5,7 @@ import (
 )
 
 type PetRepository interface {
+	GetById(petID string) (*ent.Pet, error)
 	GetByOwner(ownerID string) ([]*ent.Pet, error)
 	Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error)
 	Update(petID, name, petType, species, birthDay string) error
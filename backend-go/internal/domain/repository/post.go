@@ -1,14 +1,31 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
 )
 
 type PostRepository interface {
 	GetAllPosts() ([]*ent.Post, error)
+	GetById(postId string) (*ent.Post, error)
+	GetByIndexes(indexes []int) ([]*ent.Post, error)
+	GetTimeline(cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetFollowingTimeline(userId string, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetByTag(tagName string, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetSimilarPosts(postId, excludeUserId string, limit int) ([]*ent.Post, error)
+	SearchPosts(keyword string, embedding *pgvector.Vector, limit int) ([]*ent.Post, error)
 	GetPostsByUser(userId uuid.UUID) ([]*ent.Post, error)
-	CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error)
-	UpdatePost(postId, caption string) error
+	GetRecentPostsByUser(userId uuid.UUID, limit int) ([]*ent.Post, error)
+	// CreatePost links the post to the tags, creating the tags that do not exist yet
+	CreatePost(caption, userId, fileKey string, tags []string) (*ent.Post, error)
+	RecordTaskScore(postId, dailyTaskId string, score float64, completed bool) error
+	UpdatePost(postId, caption string, tags []string) error
 	DeletePost(postId string) error
+	GetDeletedPostsWithImage(deletedBefore time.Time, limit int) ([]*ent.Post, error)
+	MarkImageDeleted(postId string) error
+	RecordImageReapFailure(postId string) error
 }
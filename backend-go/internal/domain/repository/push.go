@@ -0,0 +1,17 @@
+package repository
+
+import "github.com/aki-13627/animalia/backend-go/internal/domain/models"
+
+// PushSender delivers push notifications through a gateway such as Expo
+type PushSender interface {
+	// Send delivers the messages and returns the tokens the gateway reported as no longer registered
+	Send(messages []models.PushMessage) ([]string, error)
+}
+
+type PushTokenRepository interface {
+	// Register ties the token to the user, moving it over when another user signed in on the device before
+	Register(userId, token, platform string) error
+	Unregister(userId, token string) error
+	ListByUser(userId string) ([]string, error)
+	DeleteTokens(tokens []string) error
+}
@@ -0,0 +1,6 @@
+package repository
+
+type RecommendationRepository interface {
+	// RecommendPostIndexes returns the indexes (Post.index) of the posts recommended for the user with the given User.index
+	RecommendPostIndexes(userIndex, offset, limit int) ([]int, error)
+}
@@ -1,9 +1,32 @@
 package repository
 
-import "mime/multipart"
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
 
 type StorageRepository interface {
-	UploadImage(file *multipart.FileHeader, directory string) (string, error)
+	PutObject(fileKey, contentType string, data []byte) error
+	// GetObject reads the object, or returns models.ErrUploadNotFound when it does not exist
+	GetObject(fileKey string) ([]byte, error)
+	// PresignUpload returns a URL the client can PUT the object to with the given content type
+	PresignUpload(fileKey, contentType string, expires time.Duration) (string, error)
+	// StatImage returns the metadata of the object, or models.ErrUploadNotFound when it does not exist
+	StatImage(fileKey string) (*models.StoredObject, error)
 	GetUrl(fileKey string) (string, error)
 	DeleteImage(fileKey string) error
 }
+
+// SignedFileRepository is implemented by storage backends whose objects are served by the API itself
+type SignedFileRepository interface {
+	// VerifySignature checks a URL issued by GetUrl (GET) or PresignUpload (PUT),
+	// returning models.ErrInvalidFileSignature when it is expired or forged
+	VerifySignature(method, fileKey, contentType, expires, signature string) error
+}
+
+// ImageReferenceRepository tells whether a stored image is already in use, so that one upload cannot be attached twice
+type ImageReferenceRepository interface {
+	// IsImageReferenced reports whether a post, pet or user refers to the image stored under the base key
+	IsImageReferenced(baseKey string) (bool, error)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/aki-13627/animalia/backend-go/internal/domain/models"
+
+// EventHub fans out stream events to the subscribers of a channel, on this and other API instances
+type EventHub interface {
+	Publish(channel string, event models.StreamEvent) error
+	// Subscribe returns the events published to the channel from now on, until unsubscribe is called.
+	// Events are dropped for subscribers that fall behind.
+	Subscribe(channel string) (events <-chan models.StreamEvent, unsubscribe func())
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+type TagRepository interface {
+	// Trending ranks the tags of the posts created since the given time,
+	// weighting each post by half for every halfLife that has passed since it was created
+	Trending(since time.Time, halfLife time.Duration, limit int) ([]models.TrendingTag, error)
+}
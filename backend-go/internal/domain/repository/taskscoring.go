@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/aki-13627/animalia/backend-go/ent/enum"
+
+// TaskScoringRepository rates how well a post image matches a task, from 0 to 100
+type TaskScoringRepository interface {
+	Score(taskType enum.TaskType, imageKey string) (float64, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+type TaskTypeRepository interface {
+	List(includeInactive bool) ([]*ent.TaskType, error)
+	Create(input models.TaskTypeInput) (*ent.TaskType, error)
+	Update(id int, input models.TaskTypeInput) (*ent.TaskType, error)
+	Deactivate(id int) error
+}
@@ -10,5 +10,7 @@ type UserRepository interface {
 	FindByEmail(email string) (*ent.User, error)
 	GetById(id string) (*ent.User, error)
 	Update(id string, name string, description string, newImageKey string) error
-	Follow(fromId string, toId string) error
+	Follow(fromId string, toId string) (bool, error)
+	Unfollow(fromId string, toId string) error
+	UpdatePushOptOuts(id string, kinds []string) error
 }
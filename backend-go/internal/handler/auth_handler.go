@@ -61,9 +61,9 @@ func (h *AuthHandler) SignIn(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message":      "ログイン成功",
 		"user":         user,
-		"accessToken":  *result.AuthenticationResult.AccessToken,
-		"idToken":      *result.AuthenticationResult.IdToken,
-		"refreshToken": *result.AuthenticationResult.RefreshToken,
+		"accessToken":  result.AccessToken,
+		"idToken":      result.IdToken,
+		"refreshToken": result.RefreshToken,
 	})
 }
 
@@ -96,8 +96,8 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 
 	// レスポンスの作成
 	resp := models.RefreshTokenResponse{
-		AccessToken: *result.AuthenticationResult.AccessToken,
-		IdToken:     *result.AuthenticationResult.IdToken,
+		AccessToken: result.AccessToken,
+		IdToken:     result.IdToken,
 	}
 
 	return c.JSON(http.StatusOK, resp)
@@ -252,3 +252,15 @@ func (h *AuthHandler) GetSession(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, user)
 }
+
+// GetJWKS serves the public keys that the tokens issued to clients are signed with
+func (h *AuthHandler) GetJWKS(c echo.Context) error {
+	keySet, err := h.authUsecase.PublicKeySet()
+	if err != nil {
+		log.Errorf("Failed to get JWK set: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "公開鍵の取得に失敗しました",
+		})
+	}
+	return c.JSONBlob(http.StatusOK, keySet)
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -17,11 +20,31 @@ func NewCommentHandler(commentUsecase usecase.CommentUsecase) *CommentHandler {
 		commentUsecase: commentUsecase,
 	}
 }
+
+// Create adds a comment to the post, or a reply when the parentId form value is set
 func (h *CommentHandler) Create(c echo.Context) error {
-	userId := c.Param("userId")
+	userId := currentUser(c).ID.String()
 	postId := c.Param("postId")
+	if !isValidID(postId) {
+		log.Errorf("Failed to create comment: invalid post ID %q", postId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid post ID",
+		})
+	}
 	content := c.FormValue("content")
-	err := h.commentUsecase.Create(userId, postId, content)
+	comment, err := h.commentUsecase.Create(userId, postId, c.FormValue("parentId"), content)
+	if errors.Is(err, models.ErrInvalidCommentContent) || errors.Is(err, models.ErrInvalidCommentParent) {
+		log.Errorf("Failed to create comment: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid comment",
+		})
+	}
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to create comment: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "Post not found",
+		})
+	}
 	if err != nil {
 		log.Errorf("Failed to create comment: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -30,12 +53,70 @@ func (h *CommentHandler) Create(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message": "Comment created successfully",
+		"comment": comment,
+	})
+}
+
+func (h *CommentHandler) Update(c echo.Context) error {
+	commentId := c.Param("commentId")
+	if !isValidID(commentId) {
+		log.Errorf("Failed to update comment: invalid comment ID %q", commentId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid comment ID",
+		})
+	}
+	comment, err := h.commentUsecase.Update(currentUser(c).ID.String(), commentId, c.FormValue("content"))
+	if errors.Is(err, models.ErrInvalidCommentContent) {
+		log.Errorf("Failed to update comment: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid comment",
+		})
+	}
+	if isForbidden(err) {
+		log.Errorf("Failed to update comment: %v", err)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "You are not allowed to edit this comment",
+		})
+	}
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to update comment: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "Comment not found",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to update comment: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to update comment",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Comment updated successfully",
+		"comment": comment,
 	})
 }
 
 func (h *CommentHandler) Delete(c echo.Context) error {
 	commentId := c.Param("commentId")
-	err := h.commentUsecase.Delete(commentId)
+	if !isValidID(commentId) {
+		log.Errorf("Failed to delete comment: invalid comment ID %q", commentId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid comment ID",
+		})
+	}
+	err := h.commentUsecase.Delete(currentUser(c).ID.String(), commentId)
+	if isForbidden(err) {
+		log.Errorf("Failed to delete comment: %v", err)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "You are not allowed to delete this comment",
+		})
+	}
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to delete comment: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "Comment not found",
+		})
+	}
 	if err != nil {
 		log.Errorf("Failed to delete comment: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -61,9 +142,16 @@ func (h *CommentHandler) Count(c echo.Context) error {
 	})
 }
 
+// GetByPostId returns one page of the post's top-level comments, oldest first
 func (h *CommentHandler) GetByPostId(c echo.Context) error {
-	postId := c.Param("postId")
-	comments, err := h.commentUsecase.GetByPostId(postId)
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get comments: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ページ指定が不正です",
+		})
+	}
+	comments, nextCursor, err := h.commentUsecase.GetByPostId(c.Param("postId"), cursor, limit)
 	if err != nil {
 		log.Errorf("Failed to get comments: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -71,6 +159,29 @@ func (h *CommentHandler) GetByPostId(c echo.Context) error {
 		})
 	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"comments": comments,
+		"comments":   comments,
+		"nextCursor": encodeNextCursor(nextCursor),
+	})
+}
+
+// GetReplies returns one page of the replies to a comment, oldest first
+func (h *CommentHandler) GetReplies(c echo.Context) error {
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get replies: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ページ指定が不正です",
+		})
+	}
+	replies, nextCursor, err := h.commentUsecase.GetReplies(c.Param("commentId"), cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get replies: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get replies",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"replies":    replies,
+		"nextCursor": encodeNextCursor(nextCursor),
 	})
 }
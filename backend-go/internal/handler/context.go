@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// UserContextKey is the key under which the auth middleware stores the signed-in user.
+const UserContextKey = "user"
+
+// currentUser returns the user resolved by the auth middleware, or nil for anonymous requests.
+func currentUser(c echo.Context) *ent.User {
+	user, ok := c.Get(UserContextKey).(*ent.User)
+	if !ok {
+		return nil
+	}
+	return user
+}
+
+// viewerId returns the ID of the signed-in user, or an empty string for anonymous requests.
+func viewerId(c echo.Context) string {
+	if user := currentUser(c); user != nil {
+		return user.ID.String()
+	}
+	return ""
+}
+
+// isCurrentUser reports whether an ID supplied by the client names the signed-in user.
+// An empty ID is accepted so that clients can omit it entirely.
+func isCurrentUser(c echo.Context, id string) bool {
+	if id == "" {
+		return true
+	}
+	user := currentUser(c)
+	return user != nil && user.ID.String() == id
+}
+
+// isValidID reports whether id is a well-formed UUID, so that a malformed ID in the request is answered with 400
+// instead of failing in the repository.
+func isValidID(id string) bool {
+	return uuid.Validate(id) == nil
+}
+
+// isForbidden reports whether the usecase refused the operation because the caller does not own the resource.
+func isForbidden(err error) bool {
+	var forbidden *usecase.ForbiddenError
+	return errors.As(err, &forbidden)
+}
+
+// requestLanguage returns the primary language of the Accept-Language header, e.g. "en" for "en-US,en;q=0.9".
+func requestLanguage(c echo.Context) string {
+	header := c.Request().Header.Get("Accept-Language")
+	lang, _, _ := strings.Cut(header, ",")
+	lang, _, _ = strings.Cut(lang, ";")
+	lang, _, _ = strings.Cut(strings.TrimSpace(lang), "-")
+	if lang == "" || lang == "*" {
+		return models.DefaultLanguage
+	}
+	return strings.ToLower(lang)
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type DailyTaskHandler struct {
+	dailyTaskUsecase usecase.DailyTaskUsecase
+}
+
+func NewDailyTaskHandler(dailyTaskUsecase usecase.DailyTaskUsecase) *DailyTaskHandler {
+	return &DailyTaskHandler{
+		dailyTaskUsecase: dailyTaskUsecase,
+	}
+}
+
+func (h *DailyTaskHandler) GetToday(c echo.Context) error {
+	userId := currentUser(c).ID.String()
+
+	task, err := h.dailyTaskUsecase.GetToday(userId, requestLanguage(c))
+	if err != nil {
+		log.Errorf("Failed to get today's task: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "今日のタスクの取得に失敗しました",
+		})
+	}
+
+	streak, err := h.dailyTaskUsecase.GetStreak(userId)
+	if err != nil {
+		log.Errorf("Failed to get streak: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "連続達成日数の取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"task":   task,
+		"streak": streak,
+	})
+}
+
+func (h *DailyTaskHandler) GetHistory(c echo.Context) error {
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get task history: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ページ指定が不正です",
+		})
+	}
+
+	tasks, nextCursor, err := h.dailyTaskUsecase.GetHistory(currentUser(c).ID.String(), requestLanguage(c), cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get task history: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "タスク履歴の取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"tasks":      tasks,
+		"nextCursor": encodeNextCursor(nextCursor),
+	})
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type FileHandler struct {
+	fileUsecase usecase.FileUsecase
+}
+
+func NewFileHandler(fileUsecase usecase.FileUsecase) *FileHandler {
+	return &FileHandler{
+		fileUsecase: fileUsecase,
+	}
+}
+
+// Get serves a file through a signed URL issued by the local storage
+func (h *FileHandler) Get(c echo.Context) error {
+	fileKey, err := url.PathUnescape(c.Param("*"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "file not found",
+		})
+	}
+
+	data, contentType, err := h.fileUsecase.Read(fileKey, c.QueryParam("expires"), c.QueryParam("signature"))
+	if err != nil {
+		return writeFileError(c, "Failed to get file", err)
+	}
+	// 署名付き URL は期限まで同じものが返されるので、クライアントにキャッシュさせる
+	c.Response().Header().Set("Cache-Control", "private, max-age=3600")
+	return c.Blob(http.StatusOK, contentType, data)
+}
+
+// Put stores a file uploaded to a signed URL issued by the local storage, like a presigned S3 PUT
+func (h *FileHandler) Put(c echo.Context) error {
+	fileKey, err := url.PathUnescape(c.Param("*"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "file not found",
+		})
+	}
+
+	data, err := io.ReadAll(io.LimitReader(c.Request().Body, models.MaxImageSize+1))
+	if err != nil {
+		log.Errorf("Failed to read uploaded file: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "failed to read file",
+		})
+	}
+
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+	if err := h.fileUsecase.Write(fileKey, contentType, c.QueryParam("expires"), c.QueryParam("signature"), data); err != nil {
+		return writeFileError(c, "Failed to put file", err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func writeFileError(c echo.Context, message string, err error) error {
+	log.Errorf("%s: %v", message, err)
+	switch {
+	case errors.Is(err, models.ErrInvalidFileSignature):
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "invalid or expired URL",
+		})
+	case errors.Is(err, models.ErrUploadNotFound):
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "file not found",
+		})
+	case errors.Is(err, models.ErrUploadTooLarge):
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]interface{}{
+			"error": "file is too large",
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "failed to access file",
+		})
+	}
+}
@@ -19,8 +19,14 @@ func NewLikeHandler(likeUsecase usecase.LikeUsecase) *LikeHandler {
 }
 
 func (h *LikeHandler) Create(c echo.Context) error {
-	userId := c.Param("userId")
+	userId := currentUser(c).ID.String()
 	postId := c.Param("postId")
+	if !isValidID(postId) {
+		log.Errorf("Failed to create like: invalid post ID %q", postId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid post ID",
+		})
+	}
 	err := h.likeUsecase.Create(userId, postId)
 	if err != nil {
 		log.Errorf("Failed to create like: %v", err)
@@ -32,8 +38,14 @@ func (h *LikeHandler) Create(c echo.Context) error {
 }
 
 func (h *LikeHandler) Delete(c echo.Context) error {
-	userId := c.Param("userId")
+	userId := currentUser(c).ID.String()
 	postId := c.Param("postId")
+	if !isValidID(postId) {
+		log.Errorf("Failed to delete like: invalid post ID %q", postId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid post ID",
+		})
+	}
 	err := h.likeUsecase.Delete(userId, postId)
 	if err != nil {
 		log.Errorf("Failed to delete like: %v", err)
@@ -46,6 +58,12 @@ func (h *LikeHandler) Delete(c echo.Context) error {
 
 func (h *LikeHandler) Count(c echo.Context) error {
 	postId := c.Param("postId")
+	if !isValidID(postId) {
+		log.Errorf("Failed to count likes: invalid post ID %q", postId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid post ID",
+		})
+	}
 	count, err := h.likeUsecase.Count(postId)
 	if err != nil {
 		log.Errorf("Failed to count likes: %v", err)
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type NotificationHandler struct {
+	notificationUsecase usecase.NotificationUsecase
+}
+
+func NewNotificationHandler(notificationUsecase usecase.NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUsecase: notificationUsecase,
+	}
+}
+
+func (h *NotificationHandler) List(c echo.Context) error {
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get notifications: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ページ指定が不正です"})
+	}
+	notifications, nextCursor, err := h.notificationUsecase.List(currentUser(c).ID.String(), cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get notifications: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "通知の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"notifications": notifications,
+		"nextCursor":    encodeNextCursor(nextCursor),
+	})
+}
+
+func (h *NotificationHandler) UnreadCount(c echo.Context) error {
+	count, err := h.notificationUsecase.CountUnread(currentUser(c).ID.String())
+	if err != nil {
+		log.Errorf("Failed to count unread notifications: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "未読件数の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"unreadCount": count})
+}
+
+func (h *NotificationHandler) MarkRead(c echo.Context) error {
+	err := h.notificationUsecase.MarkRead(currentUser(c).ID.String(), c.Param("id"))
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to mark notification as read: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "通知が見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to mark notification as read: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "通知を既読にできませんでした"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"message": "通知を既読にしました"})
+}
+
+func (h *NotificationHandler) MarkAllRead(c echo.Context) error {
+	if err := h.notificationUsecase.MarkAllRead(currentUser(c).ID.String()); err != nil {
+		log.Errorf("Failed to mark notifications as read: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "通知を既読にできませんでした"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"message": "すべての通知を既読にしました"})
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 50
+)
+
+// parsePage reads the cursor and limit query parameters of a paginated list
+func parsePage(c echo.Context) (*models.Cursor, int, error) {
+	limit, err := parseLimit(c)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cursor, err := models.DecodeCursor(c.QueryParam("cursor"))
+	if err != nil {
+		return nil, 0, err
+	}
+	return cursor, limit, nil
+}
+
+// parseLimit reads the limit query parameter, using the default page size when it is omitted
+func parseLimit(c echo.Context) (int, error) {
+	limitParam := c.QueryParam("limit")
+	if limitParam == "" {
+		return defaultPageLimit, nil
+	}
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit < 1 || limit > maxPageLimit {
+		return 0, fmt.Errorf("limit must be between 1 and %d: %q", maxPageLimit, limitParam)
+	}
+	return limit, nil
+}
+
+// encodeNextCursor converts the next cursor for the response, using null on the last page
+func encodeNextCursor(cursor *models.Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := cursor.Encode()
+	return &encoded
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -39,14 +40,14 @@ func (h *PetHandler) GetByOwner(c echo.Context) error {
 	}
 	petResponses := make([]models.PetResponse, len(pets))
 	for i, pet := range pets {
-		url, err := h.storageUsecase.GetUrl(pet.ImageKey)
+		url, thumbnailURL, err := h.storageUsecase.GetUrls(pet.ImageKey)
 		if err != nil {
 			log.Errorf("Failed to get pet image URL: %v", err)
 			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 				"error": "Failed to get pet image URL",
 			})
 		}
-		petResponses[i] = models.NewPetResponse(pet, url)
+		petResponses[i] = models.NewPetResponse(pet, url, thumbnailURL)
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -68,31 +69,33 @@ func (h *PetHandler) Create(c echo.Context) error {
 	petType := form.Value["type"][0]
 	species := form.Value["species"][0]
 	birthDay := form.Value["birthDay"][0]
-	userID := form.Value["userId"][0]
 
-	// Get the image file
-	file, err := c.FormFile("image")
-	if err != nil {
-		log.Errorf("Failed to create pet: image file is required: %v", err)
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "Image file is required",
+	// The owner is always the signed-in user
+	if !isCurrentUser(c, c.FormValue("userId")) {
+		log.Error("Failed to create pet: userId does not match the signed-in user")
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "Cannot create a pet for another user",
 		})
 	}
+	userID := currentUser(c).ID.String()
 
 	// Validate form values
-	if name == "" || petType == "" || birthDay == "" || userID == "" {
+	if name == "" || petType == "" || birthDay == "" {
 		log.Error("Failed to create pet: missing required fields")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error": "Missing required fields",
 		})
 	}
 
-	// Upload the image
-	fileKey, err := h.storageUsecase.UploadImage(file, "pets")
+	// Upload the image, or verify the one uploaded with a presigned URL
+	fileKey, err := resolveImageKey(c, h.storageUsecase, c.FormValue("imageKey"), "pets")
 	if err != nil {
-		log.Errorf("Failed to create pet: failed to upload image: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "Failed to upload image",
+		return writeUploadError(c, "Failed to create pet", err)
+	}
+	if fileKey == "" {
+		log.Error("Failed to create pet: image is required")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Image file is required",
 		})
 	}
 
@@ -117,6 +120,12 @@ func (h *PetHandler) Update(c echo.Context) error {
 			"error": "Pet ID is required",
 		})
 	}
+	if !isValidID(petId) {
+		log.Errorf("Failed to update pet: invalid petId %q", petId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid pet ID",
+		})
+	}
 	form, err := c.MultipartForm()
 	if err != nil {
 		log.Errorf("Failed to update pet: invalid form data: %v", err)
@@ -131,7 +140,19 @@ func (h *PetHandler) Update(c echo.Context) error {
 	species := form.Value["species"][0]
 	birthDay := form.Value["birthDay"][0]
 
-	if err := h.petUsecase.Update(petId, name, petType, species, birthDay); err != nil {
+	if err := h.petUsecase.Update(currentUser(c).ID.String(), petId, name, petType, species, birthDay); err != nil {
+		if isForbidden(err) {
+			log.Errorf("Failed to update pet: %v", err)
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"error": "You are not allowed to update this pet",
+			})
+		}
+		if ent.IsNotFound(err) {
+			log.Errorf("Failed to update pet: %v", err)
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Pet not found",
+			})
+		}
 		log.Errorf("Failed to update pet: failed to update pet: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Failed to update pet",
@@ -151,8 +172,26 @@ func (h *PetHandler) Delete(c echo.Context) error {
 			"error": "Pet ID is required",
 		})
 	}
+	if !isValidID(petId) {
+		log.Errorf("Failed to delete pet: invalid petId %q", petId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid pet ID",
+		})
+	}
 
-	if err := h.petUsecase.Delete(petId); err != nil {
+	if err := h.petUsecase.Delete(currentUser(c).ID.String(), petId); err != nil {
+		if isForbidden(err) {
+			log.Errorf("Failed to delete pet: %v", err)
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"error": "You are not allowed to delete this pet",
+			})
+		}
+		if ent.IsNotFound(err) {
+			log.Errorf("Failed to delete pet: %v", err)
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Pet not found",
+			})
+		}
 		log.Errorf("Failed to delete pet: failed to delete pet: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Failed to delete pet",
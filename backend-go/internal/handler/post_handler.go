@@ -1,9 +1,11 @@
 package handler
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
+	"strconv"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -24,35 +26,130 @@ func NewPostHandler(postUsecase usecase.PostUsecase, storageUsecase usecase.Stor
 
 func (h *PostHandler) GetAllPosts(c echo.Context) error {
 	log.Debug("GetAllPosts")
-	fmt.Println("GetAllPosts")
-	posts, err := h.postUsecase.GetAllPosts()
+	postResponses, err := h.postUsecase.GetAllPosts(viewerId(c))
 	if err != nil {
 		log.Errorf("Failed to get all posts: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": err.Error(),
 		})
 	}
-	log.Debug("GetAllPosts: posts", posts)
-	postResponses := make([]models.PostResponse, len(posts))
-	for i, post := range posts {
-		imageURL, err := h.storageUsecase.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": err.Error(),
-			})
-		}
-		userImageURL, err := h.storageUsecase.GetUrl(post.Edges.User.IconImageKey)
-		if err != nil {
-			log.Errorf("Failed to get user image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": err.Error(),
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts": postResponses,
+	})
+}
+
+func (h *PostHandler) GetRecommended(c echo.Context) error {
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get recommended timeline: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ページ指定が不正です",
+		})
+	}
+	offset := 0
+	if offsetParam := c.QueryParam("offset"); offsetParam != "" {
+		offset, err = strconv.Atoi(offsetParam)
+		if err != nil || offset < 0 {
+			log.Errorf("Failed to get recommended timeline: invalid offset %q", offsetParam)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "ページ指定が不正です",
 			})
 		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, userImageURL)
 	}
+
+	timeline, err := h.postUsecase.GetRecommended(currentUser(c), offset, cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get recommended timeline: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "タイムラインの取得に失敗しました",
+		})
+	}
+
+	// おすすめを取得できなかった場合は nextCursor で新着順のタイムラインを続ける
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"posts": postResponses,
+		"posts":       timeline.Posts,
+		"recommended": timeline.Recommended,
+		"nextOffset":  timeline.NextOffset,
+		"nextCursor":  encodeNextCursor(timeline.NextCursor),
+	})
+}
+
+func (h *PostHandler) GetById(c echo.Context) error {
+	postId := c.Param("id")
+	postResponse, err := h.postUsecase.GetById(viewerId(c), postId)
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to get post: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to get post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"post": postResponse,
+	})
+}
+
+func (h *PostHandler) GetSimilar(c echo.Context) error {
+	limit, err := parseLimit(c)
+	if err != nil {
+		log.Errorf("Failed to get similar posts: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "件数の指定が不正です",
+		})
+	}
+
+	posts, err := h.postUsecase.GetSimilar(viewerId(c), c.Param("id"), limit)
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to get similar posts: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to get similar posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "類似投稿の取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts": posts,
+	})
+}
+
+func (h *PostHandler) GetTimeline(c echo.Context) error {
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get timeline: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ページ指定が不正です",
+		})
+	}
+
+	// フォロー中のユーザーの投稿のみを返すモード
+	following := c.QueryParam("mode") == "following"
+	if following && currentUser(c) == nil {
+		log.Error("Failed to get timeline: following mode requires sign in")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "アクセストークンが必要です",
+		})
+	}
+
+	postResponses, nextCursor, err := h.postUsecase.GetTimeline(viewerId(c), following, cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get timeline: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "タイムラインの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      postResponses,
+		"nextCursor": encodeNextCursor(nextCursor),
 	})
 }
 
@@ -61,6 +158,7 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 		Caption     string  `json:"caption,omitempty" form:"caption"`
 		UserId      string  `json:"userId,omitempty" form:"userId"`
 		DailyTaskId *string `json:"dailyTaskId,omitempty" form:"dailyTaskId"`
+		ImageKey    string  `json:"imageKey,omitempty" form:"imageKey"`
 	}
 	if err := c.Bind(&req); err != nil {
 		log.Error("Failed to create post: invalid request body")
@@ -69,6 +167,15 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 		})
 	}
 
+	// The poster is always the signed-in user
+	if !isCurrentUser(c, req.UserId) {
+		log.Error("Failed to create post: userId does not match the signed-in user")
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "他のユーザーとして投稿することはできません",
+		})
+	}
+	userId := currentUser(c).ID.String()
+
 	// Validate request
 	if req.Caption == "" {
 		log.Error("Failed to create post: caption is empty")
@@ -77,24 +184,49 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 		})
 	}
 
-	file, err := c.FormFile("image")
+	// Upload the image, or verify the one uploaded with a presigned URL
+	fileKey, err := resolveImageKey(c, h.storageUsecase, req.ImageKey, "posts")
 	if err != nil {
-		log.Errorf("Failed to create post: image file is required: %v", err)
+		return writeUploadError(c, "Failed to create post", err)
+	}
+	if fileKey == "" {
+		log.Error("Failed to create post: image is required")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error": "画像ファイルが必要です",
 		})
 	}
 
-	// Upload the image
-	fileKey, err := h.storageUsecase.UploadImage(file, "posts")
+	post, taskResult, err := h.postUsecase.CreatePost(req.Caption, userId, fileKey, req.DailyTaskId)
 	if err != nil {
-		log.Errorf("Failed to create post: failed to upload image: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "画像のアップロードに失敗しました",
+		// 作成できなかった投稿の画像は残さない
+		if deleteErr := h.storageUsecase.DeleteImage(fileKey); deleteErr != nil {
+			log.Errorf("Failed to delete image of failed post: %v", deleteErr)
+		}
+	}
+	if isForbidden(err) {
+		log.Errorf("Failed to create post: %v", err)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "他のユーザーのタスクに投稿することはできません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to create post: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "タスクが見つかりません",
+		})
+	}
+	if errors.Is(err, models.ErrDailyTaskNotToday) {
+		log.Errorf("Failed to create post: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "今日のタスクではありません",
+		})
+	}
+	if errors.Is(err, models.ErrDailyTaskCompleted) {
+		log.Errorf("Failed to create post: %v", err)
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"error": "このタスクは既に達成済みです",
 		})
 	}
-
-	post, err := h.postUsecase.CreatePost(req.Caption, req.UserId, fileKey, req.DailyTaskId)
 	if err != nil {
 		log.Errorf("Failed to create post: failed to create post: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -105,5 +237,88 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message": "投稿が作成されました",
 		"post":    post,
+		"task":    taskResult,
+	})
+}
+
+func (h *PostHandler) UpdatePost(c echo.Context) error {
+	postId := c.Param("id")
+	if !isValidID(postId) {
+		log.Errorf("Failed to update post: invalid post ID %q", postId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "投稿 ID が不正です",
+		})
+	}
+	var req struct {
+		Caption string `json:"caption" form:"caption"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Error("Failed to update post: invalid request body")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+	if req.Caption == "" {
+		log.Error("Failed to update post: caption is empty")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "情報が不足しています",
+		})
+	}
+
+	err := h.postUsecase.UpdatePost(currentUser(c).ID.String(), postId, req.Caption)
+	if isForbidden(err) {
+		log.Errorf("Failed to update post: %v", err)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "この投稿を編集する権限がありません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to update post: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to update post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の更新に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿が更新されました",
+	})
+}
+
+func (h *PostHandler) DeletePost(c echo.Context) error {
+	postId := c.Param("id")
+	if !isValidID(postId) {
+		log.Errorf("Failed to delete post: invalid post ID %q", postId)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "投稿 ID が不正です",
+		})
+	}
+	err := h.postUsecase.DeletePost(currentUser(c).ID.String(), postId)
+	if isForbidden(err) {
+		log.Errorf("Failed to delete post: %v", err)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "この投稿を削除する権限がありません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to delete post: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to delete post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の削除に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿が削除されました",
 	})
 }
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type PushHandler struct {
+	pushUsecase usecase.PushUsecase
+}
+
+func NewPushHandler(pushUsecase usecase.PushUsecase) *PushHandler {
+	return &PushHandler{
+		pushUsecase: pushUsecase,
+	}
+}
+
+func (h *PushHandler) RegisterToken(c echo.Context) error {
+	var req struct {
+		Token    string `json:"token"`
+		Platform string `json:"platform"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to register push token: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "リクエストの形式が不正です"})
+	}
+
+	err := h.pushUsecase.RegisterToken(currentUser(c).ID.String(), req.Token, req.Platform)
+	if errors.Is(err, models.ErrInvalidPushToken) {
+		log.Errorf("Failed to register push token: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "プッシュトークンの形式が不正です"})
+	}
+	if err != nil {
+		log.Errorf("Failed to register push token: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "プッシュトークンの登録に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"message": "プッシュトークンを登録しました"})
+}
+
+func (h *PushHandler) UnregisterToken(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		log.Error("Failed to unregister push token: token is empty")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
+	}
+	if err := h.pushUsecase.UnregisterToken(currentUser(c).ID.String(), token); err != nil {
+		log.Errorf("Failed to unregister push token: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "プッシュトークンの削除に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"message": "プッシュトークンを削除しました"})
+}
+
+func (h *PushHandler) GetPreferences(c echo.Context) error {
+	preferences, err := h.pushUsecase.GetPreferences(currentUser(c).ID.String())
+	if err != nil {
+		log.Errorf("Failed to get push preferences: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "通知設定の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, preferences)
+}
+
+// UpdatePreferences takes a map from notification kind to whether it is pushed, e.g. {"like": false}
+func (h *PushHandler) UpdatePreferences(c echo.Context) error {
+	var req models.PushPreferences
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to update push preferences: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "リクエストの形式が不正です"})
+	}
+
+	preferences, err := h.pushUsecase.UpdatePreferences(currentUser(c).ID.String(), req)
+	if errors.Is(err, models.ErrInvalidPushPreference) {
+		log.Errorf("Failed to update push preferences: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "通知の種類が不正です"})
+	}
+	if err != nil {
+		log.Errorf("Failed to update push preferences: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "通知設定の更新に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, preferences)
+}
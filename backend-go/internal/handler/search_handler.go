@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type SearchHandler struct {
+	searchUsecase usecase.SearchUsecase
+}
+
+func NewSearchHandler(searchUsecase usecase.SearchUsecase) *SearchHandler {
+	return &SearchHandler{
+		searchUsecase: searchUsecase,
+	}
+}
+
+func (h *SearchHandler) SearchPosts(c echo.Context) error {
+	query := c.QueryParam("q")
+	if strings.TrimSpace(query) == "" {
+		log.Error("Failed to search posts: query is empty")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "検索キーワードが必要です",
+		})
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		log.Errorf("Failed to search posts: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "件数の指定が不正です",
+		})
+	}
+
+	posts, err := h.searchUsecase.SearchPosts(viewerId(c), query, limit)
+	if err != nil {
+		log.Errorf("Failed to search posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の検索に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts": posts,
+	})
+}
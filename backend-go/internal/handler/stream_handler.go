@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// streamHeartbeatInterval keeps idle streams from being closed by proxies and load balancers
+const streamHeartbeatInterval = 25 * time.Second
+
+type StreamHandler struct {
+	streamUsecase usecase.StreamUsecase
+}
+
+func NewStreamHandler(streamUsecase usecase.StreamUsecase) *StreamHandler {
+	return &StreamHandler{
+		streamUsecase: streamUsecase,
+	}
+}
+
+// StreamPost streams the comment-created, comment-updated, comment-deleted and like-count events of a post as Server-Sent Events
+func (h *StreamHandler) StreamPost(c echo.Context) error {
+	events, unsubscribe, err := h.streamUsecase.SubscribePost(c.Param("id"))
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to stream post: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to stream post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の購読に失敗しました",
+		})
+	}
+	defer unsubscribe()
+	return writeEventStream(c, events)
+}
+
+// StreamNotifications streams the notification events of the signed-in user as Server-Sent Events
+func (h *StreamHandler) StreamNotifications(c echo.Context) error {
+	events, unsubscribe := h.streamUsecase.SubscribeUser(currentUser(c).ID.String())
+	defer unsubscribe()
+	return writeEventStream(c, events)
+}
+
+// writeEventStream writes events until the client disconnects, with a comment line as heartbeat while idle
+func writeEventStream(c echo.Context, events <-chan models.StreamEvent) error {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	// nginx がレスポンスをバッファリングしないようにする
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event.Type, event.Data); err != nil {
+				return nil
+			}
+			res.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
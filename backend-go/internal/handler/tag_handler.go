@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type TagHandler struct {
+	tagUsecase usecase.TagUsecase
+}
+
+func NewTagHandler(tagUsecase usecase.TagUsecase) *TagHandler {
+	return &TagHandler{
+		tagUsecase: tagUsecase,
+	}
+}
+
+func (h *TagHandler) GetPosts(c echo.Context) error {
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get posts by tag: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ページ指定が不正です",
+		})
+	}
+
+	posts, nextCursor, err := h.tagUsecase.GetPosts(viewerId(c), c.Param("name"), cursor, limit)
+	if errors.Is(err, models.ErrInvalidTag) {
+		log.Errorf("Failed to get posts by tag: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "タグ名が不正です",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to get posts by tag: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      posts,
+		"nextCursor": encodeNextCursor(nextCursor),
+	})
+}
+
+func (h *TagHandler) GetTrending(c echo.Context) error {
+	limit, err := parseLimit(c)
+	if err != nil {
+		log.Errorf("Failed to get trending tags: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "件数の指定が不正です",
+		})
+	}
+
+	tags, err := h.tagUsecase.GetTrending(limit)
+	if err != nil {
+		log.Errorf("Failed to get trending tags: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "トレンドタグの取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"tags": tags,
+	})
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type TaskTypeHandler struct {
+	taskTypeUsecase usecase.TaskTypeUsecase
+}
+
+func NewTaskTypeHandler(taskTypeUsecase usecase.TaskTypeUsecase) *TaskTypeHandler {
+	return &TaskTypeHandler{
+		taskTypeUsecase: taskTypeUsecase,
+	}
+}
+
+func (h *TaskTypeHandler) List(c echo.Context) error {
+	taskTypes, err := h.taskTypeUsecase.List()
+	if err != nil {
+		log.Errorf("Failed to list task types: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "タスク一覧の取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"taskTypes": taskTypes,
+	})
+}
+
+func (h *TaskTypeHandler) Create(c echo.Context) error {
+	input := models.TaskTypeInput{Weight: 1, Active: true}
+	if err := c.Bind(&input); err != nil {
+		log.Errorf("Failed to bind task type: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストの形式が不正です",
+		})
+	}
+
+	taskType, err := h.taskTypeUsecase.Create(input)
+	if err != nil {
+		return h.writeError(c, "Failed to create task type", err)
+	}
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"taskType": taskType,
+	})
+}
+
+func (h *TaskTypeHandler) Update(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to update task type: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "IDが不正です",
+		})
+	}
+	input := models.TaskTypeInput{Weight: 1, Active: true}
+	if err := c.Bind(&input); err != nil {
+		log.Errorf("Failed to bind task type: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストの形式が不正です",
+		})
+	}
+
+	taskType, err := h.taskTypeUsecase.Update(id, input)
+	if err != nil {
+		return h.writeError(c, "Failed to update task type", err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"taskType": taskType,
+	})
+}
+
+// Deactivate hides the task from future draws; tasks already handed out keep their catalog entry
+func (h *TaskTypeHandler) Deactivate(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to deactivate task type: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "IDが不正です",
+		})
+	}
+	if err := h.taskTypeUsecase.Deactivate(id); err != nil {
+		return h.writeError(c, "Failed to deactivate task type", err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *TaskTypeHandler) writeError(c echo.Context, message string, err error) error {
+	log.Errorf("%s: %v", message, err)
+	switch {
+	case errors.Is(err, usecase.ErrInvalidTaskType):
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+	case ent.IsNotFound(err):
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "タスクが見つかりません",
+		})
+	case ent.IsConstraintError(err):
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"error": "同じtypeのタスクが既に存在します",
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "タスクの保存に失敗しました",
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type UploadHandler struct {
+	storageUsecase usecase.StorageUsecase
+}
+
+func NewUploadHandler(storageUsecase usecase.StorageUsecase) *UploadHandler {
+	return &UploadHandler{
+		storageUsecase: storageUsecase,
+	}
+}
+
+// Create issues a presigned URL. The client PUTs the image to it with the same Content-Type
+// and then sends the returned key as imageKey when creating the post, pet or profile.
+func (h *UploadHandler) Create(c echo.Context) error {
+	var req struct {
+		Directory   string `json:"directory"`
+		ContentType string `json:"contentType"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to create upload: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストの形式が不正です",
+		})
+	}
+
+	upload, err := h.storageUsecase.CreateUpload(currentUser(c).ID.String(), req.Directory, req.ContentType)
+	if err != nil {
+		return writeUploadError(c, "Failed to create upload", err)
+	}
+	return c.JSON(http.StatusOK, upload)
+}
+
+// resolveImageKey processes the image sent with a create or update request and returns its stored key.
+// Clients either upload directly to storage and send imageKey, or send the file itself as the image form field.
+// The returned key is empty when the request carries neither.
+func resolveImageKey(c echo.Context, storageUsecase usecase.StorageUsecase, imageKey, directory string) (string, error) {
+	userId := currentUser(c).ID.String()
+	if imageKey != "" {
+		return storageUsecase.VerifyUpload(userId, directory, imageKey)
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		return "", nil
+	}
+	return storageUsecase.UploadImage(userId, file, directory)
+}
+
+// writeUploadError responds to an error from issuing or verifying an upload
+func writeUploadError(c echo.Context, message string, err error) error {
+	log.Errorf("%s: %v", message, err)
+	switch {
+	case errors.Is(err, models.ErrUploadNotOwned):
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "他のユーザーの画像は使用できません",
+		})
+	case errors.Is(err, models.ErrUploadNotFound):
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "アップロードされた画像が見つかりません",
+		})
+	case errors.Is(err, models.ErrUploadAlreadyUsed):
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"error": "この画像は既に使用されています",
+		})
+	case errors.Is(err, models.ErrUploadTooLarge):
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]interface{}{
+			"error": "画像のサイズが大きすぎます",
+		})
+	case errors.Is(err, models.ErrUnsupportedImageType), errors.Is(err, models.ErrInvalidUploadDirectory):
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "画像のアップロードに失敗しました",
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -20,14 +22,29 @@ func NewUserHandler(userUsecase usecase.UserUsecase, storageUsecase usecase.Stor
 	}
 }
 
+func (h *UserHandler) GetProfile(c echo.Context) error {
+	id := c.Param("id")
+	profile, err := h.userUsecase.GetProfile(viewerId(c), id)
+	if ent.IsNotFound(err) {
+		log.Errorf("Failed to get profile: %v", err)
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "ユーザーが見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to get profile: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "ユーザー情報の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, profile)
+}
+
 func (h *UserHandler) UpdateUser(c echo.Context) error {
-	// クエリからユーザーIDを取得
-	id := c.QueryParam("id")
-	if id == "" {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "userId is required",
+	// 更新対象はログイン中のユーザーのみ
+	if !isCurrentUser(c, c.QueryParam("id")) {
+		log.Error("Failed to update user: id does not match the signed-in user")
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "他のユーザーのプロフィールは更新できません",
 		})
 	}
+	id := currentUser(c).ID.String()
 
 	form, err := c.MultipartForm()
 	if err != nil {
@@ -49,29 +66,13 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 		})
 	}
 
-	// 画像ファイルが存在するか確認
-	file, fileErr := c.FormFile("image")
-	var newImageKey string
-	if fileErr == nil {
-		// 画像ファイルが送られてきた場合、古い画像があれば削除して新しい画像をアップロードする
-		if user.IconImageKey != "" {
-			if err := h.storageUsecase.DeleteImage(user.IconImageKey); err != nil {
-				log.Errorf("Failed to delete image: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": "既存画像の削除に失敗しました",
-				})
-			}
-		}
-
-		newImageKey, err = h.storageUsecase.UploadImage(file, "profile")
-		if err != nil {
-			log.Errorf("Failed to upload image: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": "新しい画像のアップロードに失敗しました",
-			})
-		}
-	} else {
-		// 画像ファイルが送られてこなかった場合は既存の画像キーを維持する
+	// 画像ファイルまたはアップロード済みの画像キーが送られてきた場合は新しい画像に差し替える
+	newImageKey, err := resolveImageKey(c, h.storageUsecase, c.FormValue("imageKey"), "profile")
+	if err != nil {
+		return writeUploadError(c, "Failed to update user", err)
+	}
+	if newImageKey == "" {
+		// 画像が送られてこなかった場合は既存の画像キーを維持する
 		newImageKey = user.IconImageKey
 	}
 
@@ -83,6 +84,13 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 		})
 	}
 
+	// 新しい画像に差し替えた場合は、更新が確定してから古い画像を削除する
+	if user.IconImageKey != "" && user.IconImageKey != newImageKey {
+		if err := h.storageUsecase.DeleteImage(user.IconImageKey); err != nil {
+			log.Errorf("Failed to delete image: %v", err)
+		}
+	}
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message":   "プロフィールが更新されました",
 		"image_key": newImageKey,
@@ -90,13 +98,22 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 }
 
 func (h *UserHandler) Follow(c echo.Context) error {
-	followerId, followedId := c.QueryParam("followerId"), c.QueryParam("followedId")
+	// フォローするのは常にログイン中のユーザー
+	if !isCurrentUser(c, c.QueryParam("followerId")) {
+		log.Error("Failed to follow: followerId does not match the signed-in user")
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "他のユーザーとしてフォローすることはできません"})
+	}
+	followerId, followedId := currentUser(c).ID.String(), c.QueryParam("followedId")
 
-	if followerId == "" || followedId == "" {
-		log.Error("Failed to follow: followerId or followedId is empty")
+	if followedId == "" {
+		log.Error("Failed to follow: followedId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
 	if err := h.userUsecase.Follow(followerId, followedId); err != nil {
+		if errors.Is(err, usecase.ErrSelfFollow) {
+			log.Errorf("Failed to follow: %v", err)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "自分自身をフォローすることはできません"})
+		}
 		log.Errorf("Failed to follow: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "フォローに失敗しました",
@@ -108,6 +125,40 @@ func (h *UserHandler) Follow(c echo.Context) error {
 	})
 }
 
+func (h *UserHandler) Unfollow(c echo.Context) error {
+	// フォロー解除するのは常にログイン中のユーザー
+	if !isCurrentUser(c, c.QueryParam("followerId")) {
+		log.Error("Failed to unfollow: followerId does not match the signed-in user")
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "他のユーザーとしてフォロー解除することはできません"})
+	}
+	followerId, followedId := currentUser(c).ID.String(), c.QueryParam("followedId")
+
+	if followedId == "" {
+		log.Error("Failed to unfollow: followedId is empty")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
+	}
+	if err := h.userUsecase.Unfollow(followerId, followedId); err != nil {
+		log.Errorf("Failed to unfollow: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "フォロー解除に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "フォロー解除しました",
+	})
+}
+
+func (h *UserHandler) GetRelationship(c echo.Context) error {
+	id := c.Param("id")
+	relationship, err := h.userUsecase.Relationship(currentUser(c).ID.String(), id)
+	if err != nil {
+		log.Errorf("Failed to get relationship: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "フォロー状態の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, relationship)
+}
+
 func (h *UserHandler) GetFollowsCount(c echo.Context) error {
 	id := c.QueryParam("id")
 	if id == "" {
@@ -142,12 +193,20 @@ func (h *UserHandler) GetFollowsUsers(c echo.Context) error {
 		log.Error("Failed to get follows users: id is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが必要です"})
 	}
-	users, err := h.userUsecase.FollowingUsers(id)
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get follows users: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ページ指定が不正です"})
+	}
+	users, nextCursor, err := h.userUsecase.FollowingUsers(viewerId(c), id, cursor, limit)
 	if err != nil {
 		log.Errorf("Failed to get follows users: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "フォロー中のユーザー一覧取得に失敗しました"})
 	}
-	return c.JSON(http.StatusOK, map[string]interface{}{"followed_users": users})
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"followed_users": users,
+		"nextCursor":     encodeNextCursor(nextCursor),
+	})
 }
 
 func (h *UserHandler) GetFollowerUsers(c echo.Context) error {
@@ -156,10 +215,18 @@ func (h *UserHandler) GetFollowerUsers(c echo.Context) error {
 		log.Error("Failed to get follower users: id is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが必要です"})
 	}
-	users, err := h.userUsecase.Followers(id)
+	cursor, limit, err := parsePage(c)
+	if err != nil {
+		log.Errorf("Failed to get follower users: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ページ指定が不正です"})
+	}
+	users, nextCursor, err := h.userUsecase.Followers(viewerId(c), id, cursor, limit)
 	if err != nil {
 		log.Errorf("Failed to get follower users: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "フォロワーの取得に失敗しました"})
 	}
-	return c.JSON(http.StatusOK, map[string]interface{}{"follower_users": users})
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"follower_users": users,
+		"nextCursor":     encodeNextCursor(nextCursor),
+	})
 }
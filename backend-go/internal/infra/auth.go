@@ -5,12 +5,14 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
@@ -47,8 +49,9 @@ func NewCognitoRepository() *CognitoRepository {
 	// Initialize JWK client for token verification
 	jwksClient := jwk.NewAutoRefresh(context.Background())
 	jwksClient.Configure(jwksURL, jwk.WithMinRefreshInterval(15*time.Minute))
+	// 取得に失敗しても起動は続け、トークンの検証時に再取得する
 	if _, err := jwksClient.Refresh(context.Background(), jwksURL); err != nil {
-		log.Fatalf("Failed to refresh JWK endpoint: %v", err)
+		log.Printf("Warning: failed to refresh JWK endpoint: %v", err)
 	}
 
 	return &CognitoRepository{
@@ -70,36 +73,38 @@ func (r *CognitoRepository) GenerateHash(username string) string {
 	return base64.StdEncoding.EncodeToString(h.Sum(nil))
 }
 
-func (r *CognitoRepository) VerifyToken(email, token string) error {
+// VerifyToken verifies the token against the cached JWK set and returns the email of its owner.
+// ID tokens carry the email as a claim; access tokens are resolved through Cognito.
+func (r *CognitoRepository) VerifyToken(token string) (string, error) {
 	parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
 	if err != nil {
-		return fmt.Errorf("failed to parse token: %w", err)
+		return "", fmt.Errorf("failed to parse token: %w", err)
 	}
 
 	if parsed.Header == nil {
-		return errors.New("token header is nil")
+		return "", errors.New("token header is nil")
 	}
 
 	kid, ok := parsed.Header["kid"].(string)
 	if !ok {
-		return errors.New("token header does not contain kid")
+		return "", errors.New("token header does not contain kid")
 	}
 
 	keySet, err := r.jwksClient.Fetch(context.Background(), r.jwksURL)
 	if err != nil {
-		return fmt.Errorf("failed to fetch JWK set: %w", err)
+		return "", fmt.Errorf("failed to fetch JWK set: %w", err)
 	}
 
 	// Get the key with the matching key ID
 	key, found := keySet.LookupKeyID(kid)
 	if !found {
-		return errors.New("matching key not found in JWK set")
+		return "", errors.New("matching key not found in JWK set")
 	}
 
 	// Convert the JWK to a public key
 	var publicKey interface{}
 	if err := key.Raw(&publicKey); err != nil {
-		return fmt.Errorf("failed to get public key: %w", err)
+		return "", fmt.Errorf("failed to get public key: %w", err)
 	}
 
 	// Parse and verify the token
@@ -109,29 +114,37 @@ func (r *CognitoRepository) VerifyToken(email, token string) error {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return publicKey, nil
-	})
+	}, jwt.WithIssuer(fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s", r.region, r.userPoolId)))
 
 	if err != nil {
-		return fmt.Errorf("failed to parse and verify token: %w", err)
+		return "", fmt.Errorf("failed to parse and verify token: %w", err)
 	}
 
 	// Get the claims from the token
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok || !parsedToken.Valid {
-		return errors.New("invalid token claims")
+		return "", errors.New("invalid token claims")
 	}
 
-	// Get the email from the claims
-	tokenEmail, ok := claims["email"].(string)
-	if !ok {
-		return errors.New("email not found in token claims")
-	}
-
-	if tokenEmail != email {
-		return errors.New("email mismatch")
+	switch claims["token_use"] {
+	case "id":
+		if aud, _ := claims["aud"].(string); aud != r.clientId {
+			return "", errors.New("token audience mismatch")
+		}
+		tokenEmail, ok := claims["email"].(string)
+		if !ok {
+			return "", errors.New("email not found in token claims")
+		}
+		return tokenEmail, nil
+	case "access":
+		if clientId, _ := claims["client_id"].(string); clientId != r.clientId {
+			return "", errors.New("token client mismatch")
+		}
+		// Access tokens do not carry the email, so ask Cognito who owns it
+		return r.GetUserEmail(token)
+	default:
+		return "", errors.New("unexpected token use")
 	}
-
-	return nil
 }
 
 func (r *CognitoRepository) CreateUser(name, email, password string) error {
@@ -178,7 +191,21 @@ func (r *CognitoRepository) VerifyEmail(email, code string) error {
 	return nil
 }
 
-func (r *CognitoRepository) SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+// tokenPair converts the result of InitiateAuth, which carries no tokens when Cognito asks for a challenge
+func tokenPair(result *cognitoidentityprovider.InitiateAuthOutput) (*models.TokenPair, error) {
+	if result.AuthenticationResult == nil {
+		return nil, fmt.Errorf("authentication requires challenge %s", result.ChallengeName)
+	}
+	auth := result.AuthenticationResult
+	return &models.TokenPair{
+		AccessToken:  aws.ToString(auth.AccessToken),
+		IdToken:      aws.ToString(auth.IdToken),
+		RefreshToken: aws.ToString(auth.RefreshToken),
+		ExpiresIn:    auth.ExpiresIn,
+	}, nil
+}
+
+func (r *CognitoRepository) SignIn(email, password string) (*models.TokenPair, error) {
 	// Generate the secret hash
 	secretHash := r.GenerateHash(email)
 
@@ -197,10 +224,10 @@ func (r *CognitoRepository) SignIn(email, password string) (*cognitoidentityprov
 		return nil, fmt.Errorf("failed to authenticate user: %w", err)
 	}
 
-	return result, nil
+	return tokenPair(result)
 }
 
-func (r *CognitoRepository) RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (r *CognitoRepository) RefreshToken(refreshToken string) (*models.TokenPair, error) {
 	// Refresh the user's tokens with Cognito
 	result, err := r.cognitoClient.InitiateAuth(context.TODO(), &cognitoidentityprovider.InitiateAuthInput{
 		AuthFlow: types.AuthFlowTypeRefreshTokenAuth,
@@ -214,7 +241,7 @@ func (r *CognitoRepository) RefreshToken(refreshToken string) (*cognitoidentityp
 		return nil, fmt.Errorf("failed to refresh tokens: %w", err)
 	}
 
-	return result, nil
+	return tokenPair(result)
 }
 
 func (r *CognitoRepository) GetUserEmail(accessToken string) (string, error) {
@@ -254,3 +281,11 @@ func (r *CognitoRepository) SignOut(accessToken string) error {
 
 	return nil
 }
+
+func (r *CognitoRepository) PublicKeySet() ([]byte, error) {
+	keySet, err := r.jwksClient.Fetch(context.Background(), r.jwksURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWK set: %w", err)
+	}
+	return json.Marshal(keySet)
+}
@@ -2,10 +2,12 @@ package infra
 
 import (
 	"context"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
-	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -19,42 +21,133 @@ func NewCommentRepository(db *ent.Client) *CommentRepository {
 	}
 }
 
-func (r *CommentRepository) Create(userId, postId, content string) error {
+func (r *CommentRepository) Create(userId, postId, parentId, content string) (*ent.Comment, error) {
 	parsedUserID, err := uuid.Parse(userId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	parsedPostId, err := uuid.Parse(postId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = r.db.Comment.Create().
+	create := r.db.Comment.Create().
 		SetUserID(parsedUserID).
 		SetPostID(parsedPostId).
-		SetContent(content).
-		Save(context.Background())
+		SetContent(content)
+	if parentId != "" {
+		parsedParentId, err := uuid.Parse(parentId)
+		if err != nil {
+			return nil, err
+		}
+		create = create.SetParentID(parsedParentId)
+	}
+	created, err := create.Save(context.Background())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return created, nil
 }
 
-func (r *CommentRepository) Delete(commentId string) error {
+func (r *CommentRepository) GetById(commentId string) (*ent.Comment, error) {
 	parsedCommentId, err := uuid.Parse(commentId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	comment, err := r.db.Comment.Query().
+		Where(comment.ID(parsedCommentId), comment.DeletedAtIsNil()).
+		WithUser().
+		WithPost(func(q *ent.PostQuery) {
+			q.WithUser()
+		}).
+		WithParent(func(q *ent.CommentQuery) {
+			q.Select(comment.FieldID, comment.FieldDeletedAt)
+		}).
+		Only(context.Background())
+	if err != nil {
+		return nil, err
 	}
 
-	err = r.db.Comment.DeleteOneID(parsedCommentId).Exec(context.Background())
+	return comment, nil
+}
+
+func (r *CommentRepository) Update(commentId, content string) error {
+	parsedCommentId, err := uuid.Parse(commentId)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return r.db.Comment.UpdateOneID(parsedCommentId).
+		Where(comment.DeletedAtIsNil()).
+		SetContent(content).
+		SetEditedAt(time.Now()).
+		Exec(context.Background())
+}
+
+func (r *CommentRepository) Delete(commentId string) (models.CommentDeletion, error) {
+	parsedCommentId, err := uuid.Parse(commentId)
+	if err != nil {
+		return models.CommentDeletion{}, err
+	}
+
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return models.CommentDeletion{}, err
+	}
+	target, err := tx.Comment.Query().
+		Where(comment.ID(parsedCommentId)).
+		WithParent(func(q *ent.CommentQuery) {
+			q.Select(comment.FieldID, comment.FieldDeletedAt)
+		}).
+		Only(ctx)
+	if err != nil {
+		return models.CommentDeletion{}, rollback(tx, err)
+	}
+	hasReplies, err := tx.Comment.Query().
+		Where(comment.HasParentWith(comment.ID(parsedCommentId))).
+		Exist(ctx)
+	if err != nil {
+		return models.CommentDeletion{}, rollback(tx, err)
+	}
+
+	// 返信が残るコメントは本文を消してプレースホルダーにする
+	if hasReplies {
+		placeholder, err := tx.Comment.UpdateOneID(parsedCommentId).
+			SetContent("").
+			SetDeletedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return models.CommentDeletion{}, rollback(tx, err)
+		}
+		return models.CommentDeletion{Placeholder: placeholder}, tx.Commit()
+	}
+
+	if err := tx.Comment.DeleteOneID(parsedCommentId).Exec(ctx); err != nil {
+		return models.CommentDeletion{}, rollback(tx, err)
+	}
+	var deletion models.CommentDeletion
+	// 最後の返信が消えたプレースホルダーは残す必要がない
+	if parent := target.Edges.Parent; parent != nil && parent.DeletedAt != nil {
+		remaining, err := tx.Comment.Query().
+			Where(comment.HasParentWith(comment.ID(parent.ID))).
+			Exist(ctx)
+		if err != nil {
+			return models.CommentDeletion{}, rollback(tx, err)
+		}
+		if !remaining {
+			if err := tx.Comment.DeleteOneID(parent.ID).Exec(ctx); err != nil {
+				return models.CommentDeletion{}, rollback(tx, err)
+			}
+			deletion.RemovedParentID = &parent.ID
+		}
+	}
+	return deletion, tx.Commit()
 }
 
+// Count counts the comments and replies of the post, excluding placeholders
 func (r *CommentRepository) Count(postId string) (int, error) {
 	parsedPostId, err := uuid.Parse(postId)
 	if err != nil {
@@ -62,7 +155,7 @@ func (r *CommentRepository) Count(postId string) (int, error) {
 	}
 
 	count, err := r.db.Comment.Query().
-		Where(comment.HasPostWith(post.ID(parsedPostId))).
+		Where(comment.PostComments(parsedPostId), comment.DeletedAtIsNil()).
 		Count(context.Background())
 	if err != nil {
 		return 0, err
@@ -71,19 +164,87 @@ func (r *CommentRepository) Count(postId string) (int, error) {
 	return count, nil
 }
 
-func (r *CommentRepository) GetByPostId(postId string) ([]*ent.Comment, error) {
+// CountByPosts counts the comments of each post in a single grouped query
+func (r *CommentRepository) CountByPosts(postIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		PostID uuid.UUID `json:"post_comments"`
+		Count  int       `json:"count"`
+	}
+	err := r.db.Comment.Query().
+		Where(comment.PostCommentsIn(postIds...), comment.DeletedAtIsNil()).
+		GroupBy(comment.FieldPostComments).
+		Aggregate(ent.Count()).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
+// CountReplies counts the replies to each comment in a single grouped query
+func (r *CommentRepository) CountReplies(commentIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		ParentID uuid.UUID `json:"comment_replies"`
+		Count    int       `json:"count"`
+	}
+	err := r.db.Comment.Query().
+		Where(comment.HasParentWith(comment.IDIn(commentIds...)), comment.DeletedAtIsNil()).
+		GroupBy(comment.RepliesColumn).
+		Aggregate(ent.Count()).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *CommentRepository) ListTopLevel(postId string, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
 	parsedPostId, err := uuid.Parse(postId)
 	if err != nil {
 		return nil, err
 	}
+	return r.pageQuery(cursor, limit,
+		comment.PostComments(parsedPostId),
+		comment.Not(comment.HasParent()),
+	).All(context.Background())
+}
 
-	comments, err := r.db.Comment.Query().
-		Where(comment.HasPostWith(post.ID(parsedPostId))).
-		WithUser().
-		All(context.Background())
+func (r *CommentRepository) ListReplies(commentId string, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
+	parsedCommentId, err := uuid.Parse(commentId)
 	if err != nil {
 		return nil, err
 	}
+	return r.pageQuery(cursor, limit,
+		comment.HasParentWith(comment.ID(parsedCommentId)),
+		comment.DeletedAtIsNil(),
+	).
+		WithParent(func(q *ent.CommentQuery) {
+			q.Select(comment.FieldID)
+		}).
+		All(context.Background())
+}
 
-	return comments, nil
+// pageQuery orders comments oldest first by (created_at, id) and starts after the cursor
+func (r *CommentRepository) pageQuery(cursor *models.Cursor, limit int, preds ...predicate.Comment) *ent.CommentQuery {
+	query := r.db.Comment.Query().Where(preds...)
+	if cursor != nil {
+		query = query.Where(comment.Or(
+			comment.CreatedAtGT(cursor.CreatedAt),
+			comment.And(comment.CreatedAtEQ(cursor.CreatedAt), comment.IDGT(cursor.ID)),
+		))
+	}
+	return query.
+		WithUser().
+		Order(comment.ByCreatedAt(), comment.ByID()).
+		Limit(limit)
 }
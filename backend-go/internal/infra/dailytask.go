@@ -0,0 +1,112 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type DailyTaskRepository struct {
+	db *ent.Client
+}
+
+func NewDailyTaskRepository(db *ent.Client) *DailyTaskRepository {
+	return &DailyTaskRepository{
+		db: db,
+	}
+}
+
+func (r *DailyTaskRepository) GetById(dailyTaskId string) (*ent.DailyTask, error) {
+	dailyTaskUUID, err := uuid.Parse(dailyTaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.db.DailyTask.Query().
+		Where(dailytask.ID(dailyTaskUUID)).
+		WithUser().
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		Only(context.Background())
+}
+
+// GetLatestByUser returns the newest task of the user created at or after since
+func (r *DailyTaskRepository) GetLatestByUser(userId string, since time.Time) (*ent.DailyTask, error) {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.db.DailyTask.Query().
+		Where(
+			dailytask.HasUserWith(user.ID(userUUID)),
+			dailytask.CreatedAtGTE(since),
+		).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		Order(dailytask.ByCreatedAt(sql.OrderDesc())).
+		First(context.Background())
+}
+
+// GetByUser returns the tasks of the user, newest first, starting after the cursor
+func (r *DailyTaskRepository) GetByUser(userId string, cursor *models.Cursor, limit int) ([]*ent.DailyTask, error) {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.DailyTask.Query().
+		Where(dailytask.HasUserWith(user.ID(userUUID)))
+	if cursor != nil {
+		query = query.Where(dailytask.Or(
+			dailytask.CreatedAtLT(cursor.CreatedAt),
+			dailytask.And(dailytask.CreatedAtEQ(cursor.CreatedAt), dailytask.IDLT(cursor.ID)),
+		))
+	}
+	return query.
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		Order(dailytask.ByCreatedAt(sql.OrderDesc()), dailytask.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(context.Background())
+}
+
+// GetCompletedDates returns the creation times of the user's completed tasks since the given time, newest first
+func (r *DailyTaskRepository) GetCompletedDates(userId string, since time.Time) ([]time.Time, error) {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		CreatedAt time.Time `json:"created_at"`
+	}
+	err = r.db.DailyTask.Query().
+		Where(
+			dailytask.HasUserWith(user.ID(userUUID)),
+			dailytask.HasPost(),
+			dailytask.CreatedAtGTE(since),
+		).
+		Order(dailytask.ByCreatedAt(sql.OrderDesc())).
+		Select(dailytask.FieldCreatedAt).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]time.Time, len(rows))
+	for i, row := range rows {
+		dates[i] = row.CreatedAt
+	}
+	return dates, nil
+}
@@ -0,0 +1,104 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/pgvector/pgvector-go"
+)
+
+// embeddingDimensions matches the vector(768) columns of posts and task_types
+const embeddingDimensions = 768
+
+// embeddingTimeout bounds an embedding request so that search does not hang on the service
+const embeddingTimeout = 5 * time.Second
+
+// EmbeddingRepository calls the text embedding endpoint of the algorithm API
+type EmbeddingRepository struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewEmbeddingRepository(baseURL string) *EmbeddingRepository {
+	return &EmbeddingRepository{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: embeddingTimeout},
+	}
+}
+
+func (r *EmbeddingRepository) EmbedText(text string) (pgvector.Vector, error) {
+	body, err := json.Marshal(map[string]string{
+		"text": text,
+	})
+	if err != nil {
+		return pgvector.Vector{}, err
+	}
+
+	resp, err := r.httpClient.Post(r.baseURL+"/embed/text", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return pgvector.Vector{}, fmt.Errorf("failed to call embedding service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return pgvector.Vector{}, fmt.Errorf("embedding service returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Embedding []float32 `json:"embedding"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return pgvector.Vector{}, fmt.Errorf("failed to decode embedding: %w", err)
+	}
+	if len(result.Embedding) != embeddingDimensions {
+		return pgvector.Vector{}, fmt.Errorf("embedding has %d dimensions, want %d", len(result.Embedding), embeddingDimensions)
+	}
+	return pgvector.NewVector(result.Embedding), nil
+}
+
+// UnconfiguredEmbeddingRepository embeds nothing, so that search matches captions only
+type UnconfiguredEmbeddingRepository struct{}
+
+func NewUnconfiguredEmbeddingRepository() *UnconfiguredEmbeddingRepository {
+	return &UnconfiguredEmbeddingRepository{}
+}
+
+func (r *UnconfiguredEmbeddingRepository) EmbedText(text string) (pgvector.Vector, error) {
+	return pgvector.Vector{}, models.ErrEmbeddingNotConfigured
+}
+
+// StubEmbeddingRepository hashes the words of the text into a normalized vector.
+// It is deterministic, so the same query always ranks posts the same way, but carries no meaning.
+type StubEmbeddingRepository struct{}
+
+func NewStubEmbeddingRepository() *StubEmbeddingRepository {
+	return &StubEmbeddingRepository{}
+}
+
+func (r *StubEmbeddingRepository) EmbedText(text string) (pgvector.Vector, error) {
+	values := make([]float32, embeddingDimensions)
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		h := fnv.New32a()
+		h.Write([]byte(word))
+		values[h.Sum32()%embeddingDimensions] += 1
+	}
+
+	var norm float64
+	for _, v := range values {
+		norm += float64(v * v)
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range values {
+			values[i] = float32(float64(values[i]) / norm)
+		}
+	}
+	return pgvector.NewVector(values), nil
+}
@@ -0,0 +1,102 @@
+package infra
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/pgvector/pgvector-go"
+)
+
+func cosineSimilarity(a, b pgvector.Vector) float64 {
+	var dot float64
+	for i, v := range a.Slice() {
+		dot += float64(v) * float64(b.Slice()[i])
+	}
+	return dot
+}
+
+func TestStubEmbeddingRepository(t *testing.T) {
+	stub := NewStubEmbeddingRepository()
+	tests := []struct {
+		name     string
+		text     string
+		wantNorm float64
+	}{
+		{"single word", "dog", 1},
+		{"several words", "dog playing in the park", 1},
+		{"repeated word", "dog dog cat", 1},
+		{"japanese", "犬 公園", 1},
+		{"empty", "", 0},
+		{"whitespace only", "  \t ", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vector, err := stub.EmbedText(tt.text)
+			if err != nil {
+				t.Fatalf("EmbedText(%q) returned error: %v", tt.text, err)
+			}
+			if got := len(vector.Slice()); got != embeddingDimensions {
+				t.Fatalf("EmbedText(%q) has %d dimensions, want %d", tt.text, got, embeddingDimensions)
+			}
+			if norm := math.Sqrt(cosineSimilarity(vector, vector)); math.Abs(norm-tt.wantNorm) > 1e-6 {
+				t.Errorf("EmbedText(%q) has norm %v, want %v", tt.text, norm, tt.wantNorm)
+			}
+			again, _ := stub.EmbedText(tt.text)
+			if !equalVectors(vector, again) {
+				t.Errorf("EmbedText(%q) is not deterministic", tt.text)
+			}
+		})
+	}
+}
+
+func TestStubEmbeddingRepositorySimilarity(t *testing.T) {
+	stub := NewStubEmbeddingRepository()
+	embed := func(text string) pgvector.Vector {
+		vector, err := stub.EmbedText(text)
+		if err != nil {
+			t.Fatalf("EmbedText(%q) returned error: %v", text, err)
+		}
+		return vector
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		closer, other string
+	}{
+		{"shared word", "dog", "dog in the park", "cat on the sofa"},
+		{"more shared words", "dog park", "dog in the park", "dog on the sofa"},
+		{"case and spacing are ignored", "Dog   PARK", "dog park", "cat sofa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := embed(tt.query)
+			closer := cosineSimilarity(query, embed(tt.closer))
+			other := cosineSimilarity(query, embed(tt.other))
+			if closer <= other {
+				t.Errorf("similarity to %q = %v, want more than %v for %q", tt.closer, closer, other, tt.other)
+			}
+		})
+	}
+}
+
+func TestUnconfiguredEmbeddingRepository(t *testing.T) {
+	_, err := NewUnconfiguredEmbeddingRepository().EmbedText("dog")
+	if !errors.Is(err, models.ErrEmbeddingNotConfigured) {
+		t.Errorf("EmbedText error = %v, want ErrEmbeddingNotConfigured", err)
+	}
+}
+
+func equalVectors(a, b pgvector.Vector) bool {
+	if len(a.Slice()) != len(b.Slice()) {
+		return false
+	}
+	for i, v := range a.Slice() {
+		if b.Slice()[i] != v {
+			return false
+		}
+	}
+	return true
+}
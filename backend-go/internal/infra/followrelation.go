@@ -3,9 +3,11 @@ package infra
 import (
 	"context"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -47,38 +49,81 @@ func (r *FollowRelationRepository) CountFollowers(userId string) (int, error) {
 	return int(count), nil
 }
 
-func (r *FollowRelationRepository) Followings(userId string) ([]*ent.User, error) {
+// Followings returns the relations from the user, newest first, with the followed users loaded
+func (r *FollowRelationRepository) Followings(userId string, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
 	userUUID, err := uuid.Parse(userId)
 	if err != nil {
 		return nil, err
 	}
-	followings, err := r.db.FollowRelation.Query().
+	return r.pageQuery(cursor, limit).
 		Where(followrelation.HasFromWith(user.ID(userUUID))).
+		WithTo().
 		All(context.Background())
+}
+
+// Followers returns the relations to the user, newest first, with the following users loaded
+func (r *FollowRelationRepository) Followers(userId string, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+	userUUID, err := uuid.Parse(userId)
 	if err != nil {
 		return nil, err
 	}
-	users := make([]*ent.User, len(followings))
-	for i, following := range followings {
-		users[i] = following.Edges.To
+	return r.pageQuery(cursor, limit).
+		Where(followrelation.HasToWith(user.ID(userUUID))).
+		WithFrom().
+		All(context.Background())
+}
+
+// pageQuery orders relations newest first by (created_at, id) and starts after the cursor
+func (r *FollowRelationRepository) pageQuery(cursor *models.Cursor, limit int) *ent.FollowRelationQuery {
+	query := r.db.FollowRelation.Query()
+	if cursor != nil {
+		query = query.Where(followrelation.Or(
+			followrelation.CreatedAtLT(cursor.CreatedAt),
+			followrelation.And(followrelation.CreatedAtEQ(cursor.CreatedAt), followrelation.IDLT(cursor.ID)),
+		))
 	}
-	return users, nil
+	return query.
+		Order(followrelation.ByCreatedAt(sql.OrderDesc()), followrelation.ByID(sql.OrderDesc())).
+		Limit(limit)
 }
 
-func (r *FollowRelationRepository) Followers(userId string) ([]*ent.User, error) {
-	userUUID, err := uuid.Parse(userId)
+func (r *FollowRelationRepository) IsFollowing(fromId string, toId string) (bool, error) {
+	fromUUID, err := uuid.Parse(fromId)
+	if err != nil {
+		return false, err
+	}
+	toUUID, err := uuid.Parse(toId)
+	if err != nil {
+		return false, err
+	}
+	return r.db.FollowRelation.Query().
+		Where(
+			followrelation.HasFromWith(user.ID(fromUUID)),
+			followrelation.HasToWith(user.ID(toUUID)),
+		).
+		Exist(context.Background())
+}
+
+// FollowingIds returns which of the given users are followed by the user
+func (r *FollowRelationRepository) FollowingIds(fromId string, toIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	fromUUID, err := uuid.Parse(fromId)
 	if err != nil {
 		return nil, err
 	}
-	followers, err := r.db.FollowRelation.Query().
-		Where(followrelation.HasToWith(user.ID(userUUID))).
-		All(context.Background())
+	followedIds, err := r.db.FollowRelation.Query().
+		Where(
+			followrelation.HasFromWith(user.ID(fromUUID)),
+			followrelation.HasToWith(user.IDIn(toIds...)),
+		).
+		QueryTo().
+		IDs(context.Background())
 	if err != nil {
 		return nil, err
 	}
-	users := make([]*ent.User, len(followers))
-	for i, follower := range followers {
-		users[i] = follower.Edges.From
+
+	following := make(map[uuid.UUID]bool, len(followedIds))
+	for _, id := range followedIds {
+		following[id] = true
 	}
-	return users, nil
+	return following, nil
 }
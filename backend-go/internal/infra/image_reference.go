@@ -0,0 +1,37 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+)
+
+type ImageReferenceRepository struct {
+	db *ent.Client
+}
+
+func NewImageReferenceRepository(db *ent.Client) *ImageReferenceRepository {
+	return &ImageReferenceRepository{
+		db: db,
+	}
+}
+
+// IsImageReferenced looks for the images under the base key in posts, pets and user icons.
+// Soft-deleted posts count as well, since their image is removed later by the reaper.
+func (r *ImageReferenceRepository) IsImageReferenced(baseKey string) (bool, error) {
+	ctx := context.Background()
+	prefix := baseKey + "/"
+
+	used, err := r.db.Post.Query().Where(post.ImageKeyHasPrefix(prefix)).Exist(ctx)
+	if err != nil || used {
+		return used, err
+	}
+	used, err = r.db.Pet.Query().Where(pet.ImageKeyHasPrefix(prefix)).Exist(ctx)
+	if err != nil || used {
+		return used, err
+	}
+	return r.db.User.Query().Where(user.IconImageKeyHasPrefix(prefix)).Exist(ctx)
+}
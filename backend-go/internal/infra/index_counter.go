@@ -0,0 +1,96 @@
+package infra
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/indexcounter"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+)
+
+// Names of the counters in index_counters
+const (
+	UserIndexCounter = "users"
+	PostIndexCounter = "posts"
+)
+
+// allocateIndex reserves the next index of the named counter inside tx.
+// The counter row stays locked until tx ends, so concurrent inserts wait for each other,
+// and rolling back the insert also gives its index back, keeping the indexes dense.
+func allocateIndex(ctx context.Context, tx *ent.Tx, name string) (int, error) {
+	for {
+		updated, err := tx.IndexCounter.Update().
+			Where(indexcounter.Name(name)).
+			AddNextValue(1).
+			Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if updated > 0 {
+			counter, err := tx.IndexCounter.Query().
+				Where(indexcounter.Name(name)).
+				Only(ctx)
+			if err != nil {
+				return 0, err
+			}
+			return counter.NextValue - 1, nil
+		}
+
+		// 初回のみ、既存の index の最大値の次から採番を始める
+		next, err := NextUnusedIndex(ctx, tx.Client(), name)
+		if err != nil {
+			return 0, err
+		}
+		err = tx.IndexCounter.Create().
+			SetName(name).
+			SetNextValue(next).
+			OnConflictColumns(indexcounter.FieldName).
+			Ignore().
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// NextUnusedIndex returns one past the largest index in the table of the named counter
+func NextUnusedIndex(ctx context.Context, client *ent.Client, name string) (int, error) {
+	var (
+		last *int
+		err  error
+	)
+	switch name {
+	case UserIndexCounter:
+		var u *ent.User
+		u, err = client.User.Query().
+			Where(user.IndexNotNil()).
+			Order(user.ByIndex(sql.OrderDesc())).
+			Select(user.FieldIndex).
+			First(ctx)
+		if u != nil {
+			last = &u.Index
+		}
+	case PostIndexCounter:
+		var p *ent.Post
+		p, err = client.Post.Query().
+			Where(post.IndexNotNil()).
+			Order(post.ByIndex(sql.OrderDesc())).
+			Select(post.FieldIndex).
+			First(ctx)
+		if p != nil {
+			last = &p.Index
+		}
+	}
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if last == nil {
+		return 0, nil
+	}
+	return *last + 1, nil
+}
@@ -82,3 +82,50 @@ func (r *LikeRepository) Count(postId string) (int, error) {
 
 	return count, nil
 }
+
+// CountByPosts counts the likes of each post in a single grouped query
+func (r *LikeRepository) CountByPosts(postIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		PostID uuid.UUID `json:"post_likes"`
+		Count  int       `json:"count"`
+	}
+	err := r.db.Like.Query().
+		Where(like.HasPostWith(post.IDIn(postIds...))).
+		GroupBy(like.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
+// LikedPostIds returns which of the posts the user has liked
+func (r *LikeRepository) LikedPostIds(userID string, postIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	parsedUserID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	likedIds, err := r.db.Like.Query().
+		Where(
+			like.HasUserWith(user.ID(parsedUserID)),
+			like.HasPostWith(post.IDIn(postIds...)),
+		).
+		QueryPost().
+		IDs(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	liked := make(map[uuid.UUID]bool, len(likedIds))
+	for _, id := range likedIds {
+		liked[id] = true
+	}
+	return liked, nil
+}
@@ -0,0 +1,323 @@
+package infra
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/localaccount"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/jwk"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	localAuthIssuer = "animalia-local"
+	// Lifetimes follow the defaults of a Cognito app client
+	localTokenExpiry        = time.Hour
+	localRefreshTokenExpiry = 30 * 24 * time.Hour
+	verificationCodeExpiry  = 24 * time.Hour
+	minPasswordLength       = 8
+)
+
+// LocalAuthRepository is an AuthRepository that runs without AWS.
+// Passwords are stored as bcrypt hashes in local_accounts, tokens are RS256 JWTs signed with a local key,
+// and verification codes are written to the log instead of being mailed.
+type LocalAuthRepository struct {
+	db         *ent.Client
+	privateKey *rsa.PrivateKey
+	keyID      string
+	now        func() time.Time
+}
+
+// NewLocalAuthRepository signs tokens with the RSA key in keyFile, generating the key on first use
+// so that tokens stay valid across restarts
+func NewLocalAuthRepository(db *ent.Client, keyFile string) *LocalAuthRepository {
+	privateKey, err := loadOrCreateRSAKey(keyFile)
+	if err != nil {
+		log.Fatalf("Failed to load local auth key: %v", err)
+	}
+	publicKey, err := jwk.New(&privateKey.PublicKey)
+	if err != nil {
+		log.Fatalf("Failed to load local auth key: %v", err)
+	}
+	thumbprint, err := publicKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		log.Fatalf("Failed to load local auth key: %v", err)
+	}
+
+	return &LocalAuthRepository{
+		db:         db,
+		privateKey: privateKey,
+		keyID:      base64.RawURLEncoding.EncodeToString(thumbprint),
+		now:        time.Now,
+	}
+}
+
+func loadOrCreateRSAKey(keyFile string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(keyFile)
+	if err == nil {
+		return parseRSAPrivateKey(string(data))
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	data = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	if err := os.WriteFile(keyFile, data, 0o600); err != nil {
+		return nil, err
+	}
+	log.Printf("Generated local auth key: %s", keyFile)
+	return privateKey, nil
+}
+
+// VerifyToken accepts ID and access tokens, like the Cognito implementation
+func (r *LocalAuthRepository) VerifyToken(token string) (string, error) {
+	claims, err := r.verify(token, "id", "access")
+	if err != nil {
+		return "", err
+	}
+	return claims.Email, nil
+}
+
+func (r *LocalAuthRepository) CreateUser(name, email, password string) error {
+	if len(password) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	code, err := verificationCode()
+	if err != nil {
+		return err
+	}
+
+	err = r.db.LocalAccount.Create().
+		SetEmail(email).
+		SetPasswordHash(string(hash)).
+		SetVerificationCode(code).
+		SetVerificationExpiresAt(r.now().Add(verificationCodeExpiry)).
+		Exec(context.Background())
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("このメールアドレスは既に登録されています")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create local account: %w", err)
+	}
+
+	log.Printf("Verification code for %s: %s", email, code)
+	return nil
+}
+
+func (r *LocalAuthRepository) VerifyEmail(email, code string) error {
+	account, err := r.db.LocalAccount.Query().
+		Where(localaccount.Email(email)).
+		Only(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to confirm user's email address: %w", err)
+	}
+	if account.EmailVerified {
+		return nil
+	}
+	if account.VerificationCode == "" || account.VerificationCode != code {
+		return errors.New("verification code does not match")
+	}
+	if account.VerificationExpiresAt == nil || r.now().After(*account.VerificationExpiresAt) {
+		return errors.New("verification code has expired")
+	}
+
+	return account.Update().
+		SetEmailVerified(true).
+		ClearVerificationCode().
+		ClearVerificationExpiresAt().
+		Exec(context.Background())
+}
+
+func (r *LocalAuthRepository) SignIn(email, password string) (*models.TokenPair, error) {
+	account, err := r.db.LocalAccount.Query().
+		Where(localaccount.Email(email)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, errors.New("incorrect email or password")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(account.PasswordHash), []byte(password)); err != nil {
+		return nil, errors.New("incorrect email or password")
+	}
+	if !account.EmailVerified {
+		return nil, errors.New("user is not confirmed")
+	}
+
+	tokens, err := r.issueTokens(account)
+	if err != nil {
+		return nil, err
+	}
+	tokens.RefreshToken, err = r.sign(account, "refresh", localRefreshTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *LocalAuthRepository) RefreshToken(refreshToken string) (*models.TokenPair, error) {
+	claims, err := r.verify(refreshToken, "refresh")
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh tokens: %w", err)
+	}
+	account, err := r.db.LocalAccount.Query().
+		Where(localaccount.Email(claims.Email)).
+		Only(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh tokens: %w", err)
+	}
+	return r.issueTokens(account)
+}
+
+func (r *LocalAuthRepository) GetUserEmail(accessToken string) (string, error) {
+	claims, err := r.verify(accessToken, "access")
+	if err != nil {
+		return "", fmt.Errorf("failed to get user information: %w", err)
+	}
+	return claims.Email, nil
+}
+
+// SignOut revokes every token issued to the user, like Cognito's global sign out
+func (r *LocalAuthRepository) SignOut(accessToken string) error {
+	claims, err := r.verify(accessToken, "access")
+	if err != nil {
+		return fmt.Errorf("failed to sign user out: %w", err)
+	}
+	err = r.db.LocalAccount.Update().
+		Where(localaccount.Email(claims.Email)).
+		AddTokenVersion(1).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to sign user out: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) PublicKeySet() ([]byte, error) {
+	key, err := jwk.New(&r.privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range map[string]string{
+		jwk.KeyIDKey:     r.keyID,
+		jwk.AlgorithmKey: jwt.SigningMethodRS256.Alg(),
+		jwk.KeyUsageKey:  "sig",
+	} {
+		if err := key.Set(name, value); err != nil {
+			return nil, err
+		}
+	}
+	keySet := jwk.NewSet()
+	keySet.Add(key)
+	return json.Marshal(keySet)
+}
+
+// localClaims mirrors the claims of Cognito tokens that the API relies on.
+// Version is compared with the token version of the account, so that signing out revokes the token.
+type localClaims struct {
+	Email    string `json:"email"`
+	TokenUse string `json:"token_use"`
+	Version  int    `json:"ver"`
+	jwt.RegisteredClaims
+}
+
+func (r *LocalAuthRepository) issueTokens(account *ent.LocalAccount) (*models.TokenPair, error) {
+	accessToken, err := r.sign(account, "access", localTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := r.sign(account, "id", localTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return &models.TokenPair{
+		AccessToken: accessToken,
+		IdToken:     idToken,
+		ExpiresIn:   int32(localTokenExpiry.Seconds()),
+	}, nil
+}
+
+func (r *LocalAuthRepository) sign(account *ent.LocalAccount, tokenUse string, expiry time.Duration) (string, error) {
+	now := r.now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, localClaims{
+		Email:    account.Email,
+		TokenUse: tokenUse,
+		Version:  account.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    localAuthIssuer,
+			Subject:   account.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+		},
+	})
+	token.Header["kid"] = r.keyID
+	signed, err := token.SignedString(r.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, nil
+}
+
+// verify checks the signature, expiry and use of the token, and that the user has not signed out since it was issued
+func (r *LocalAuthRepository) verify(token string, tokenUses ...string) (*localClaims, error) {
+	claims := &localClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return &r.privateKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}), jwt.WithIssuer(localAuthIssuer), jwt.WithTimeFunc(r.now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse and verify token: %w", err)
+	}
+
+	validUse := false
+	for _, tokenUse := range tokenUses {
+		validUse = validUse || claims.TokenUse == tokenUse
+	}
+	if !validUse {
+		return nil, errors.New("unexpected token use")
+	}
+
+	account, err := r.db.LocalAccount.Query().
+		Where(localaccount.Email(claims.Email)).
+		Only(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	if account.TokenVersion != claims.Version {
+		return nil, errors.New("token has been revoked")
+	}
+	return claims, nil
+}
+
+// verificationCode returns a random six digit code, the format Cognito mails
+func verificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
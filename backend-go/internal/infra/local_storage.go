@@ -0,0 +1,190 @@
+package infra
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+// LocalFilesPath is where the API serves the objects of LocalStorageRepository (routes.SetupFileRoutes)
+const LocalFilesPath = "/files"
+
+// LocalStorageRepository stores objects on local disk for development and tests.
+// Its URLs point at LocalFilesPath on the API and are signed with HMAC-SHA256 so that they expire like presigned S3 URLs.
+type LocalStorageRepository struct {
+	dir     string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocalStorageRepository signs URLs with secret. Without one a random secret is generated,
+// so URLs issued before a restart stop working.
+func NewLocalStorageRepository(dir, baseURL, secret string) *LocalStorageRepository {
+	key := []byte(secret)
+	if secret == "" {
+		log.Println("Warning: STORAGE_SIGNING_SECRET is not set, file URLs will be invalidated on restart")
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("Failed to generate signing secret: %v", err)
+		}
+	}
+	return &LocalStorageRepository{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/") + LocalFilesPath,
+		secret:  key,
+		now:     time.Now,
+	}
+}
+
+// filePath maps the key to a path under the storage directory, rejecting keys that would escape it
+func (r *LocalStorageRepository) filePath(fileKey string) (string, error) {
+	cleaned := path.Clean("/" + fileKey)
+	if fileKey == "" || cleaned != "/"+fileKey {
+		return "", fmt.Errorf("%w: invalid key %q", models.ErrUploadNotFound, fileKey)
+	}
+	return filepath.Join(r.dir, filepath.FromSlash(fileKey)), nil
+}
+
+func (r *LocalStorageRepository) PutObject(fileKey, contentType string, data []byte) error {
+	filePath, err := r.filePath(fileKey)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// 書き込み途中のファイルを読まれないよう、一時ファイルに書いてから置き換える
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalStorageRepository) GetObject(fileKey string) ([]byte, error) {
+	filePath, err := r.filePath(fileKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, models.ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, nil
+}
+
+func (r *LocalStorageRepository) PresignUpload(fileKey, contentType string, expires time.Duration) (string, error) {
+	return r.signedURL("PUT", fileKey, contentType, expires), nil
+}
+
+// StatImage derives the content type from the extension, since local files carry no metadata
+func (r *LocalStorageRepository) StatImage(fileKey string) (*models.StoredObject, error) {
+	filePath, err := r.filePath(fileKey)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, models.ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file metadata: %w", err)
+	}
+	return &models.StoredObject{
+		ContentType: contentTypeOf(fileKey),
+		Size:        info.Size(),
+	}, nil
+}
+
+func (r *LocalStorageRepository) GetUrl(fileKey string) (string, error) {
+	return r.signedURL("GET", fileKey, "", PresignedURLExpiry), nil
+}
+
+func (r *LocalStorageRepository) DeleteImage(fileKey string) error {
+	filePath, err := r.filePath(fileKey)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("画像の削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// VerifySignature checks a URL issued by GetUrl (GET) or PresignUpload (PUT).
+// For uploads the request must carry the content type the URL was issued for.
+func (r *LocalStorageRepository) VerifySignature(method, fileKey, contentType, expires, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid expiry", models.ErrInvalidFileSignature)
+	}
+	if r.now().Unix() >= expiresAt {
+		return fmt.Errorf("%w: expired", models.ErrInvalidFileSignature)
+	}
+	if method != "PUT" {
+		contentType = ""
+	}
+	expected := r.sign(method, fileKey, contentType, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return models.ErrInvalidFileSignature
+	}
+	return nil
+}
+
+func (r *LocalStorageRepository) signedURL(method, fileKey, contentType string, expires time.Duration) string {
+	expiresAt := r.now().Add(expires).Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt, 10))
+	query.Set("signature", r.sign(method, fileKey, contentType, expiresAt))
+	return objectURL(r.baseURL, fileKey) + "?" + query.Encode()
+}
+
+func (r *LocalStorageRepository) sign(method, fileKey, contentType string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, r.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d", method, fileKey, contentType, expiresAt)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func contentTypeOf(fileKey string) string {
+	extension := path.Ext(fileKey)
+	for contentType, imageExtension := range models.ImageExtensions {
+		if imageExtension == extension {
+			return contentType
+		}
+	}
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
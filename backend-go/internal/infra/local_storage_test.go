@@ -0,0 +1,185 @@
+package infra
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+func newTestLocalStorage(t *testing.T, now time.Time) *LocalStorageRepository {
+	t.Helper()
+	repo := NewLocalStorageRepository(t.TempDir(), "http://localhost:3000/", "secret")
+	repo.now = func() time.Time { return now }
+	return repo
+}
+
+// signedQuery splits a URL issued by the repository into its key, expiry and signature
+func signedQuery(t *testing.T, rawURL string) (string, string, string) {
+	t.Helper()
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", rawURL, err)
+	}
+	fileKey := strings.TrimPrefix(parsed.Path, LocalFilesPath+"/")
+	return fileKey, parsed.Query().Get("expires"), parsed.Query().Get("signature")
+}
+
+func TestLocalStorageRepositoryVerifySignature(t *testing.T) {
+	issuedAt := time.Unix(1_700_000_000, 0)
+	repo := newTestLocalStorage(t, issuedAt)
+
+	getURL, err := repo.GetUrl("posts/a/original.jpg")
+	if err != nil {
+		t.Fatalf("GetUrl returned error: %v", err)
+	}
+	getKey, getExpires, getSignature := signedQuery(t, getURL)
+	putURL, err := repo.PresignUpload("uploads/b", "image/jpeg", time.Minute)
+	if err != nil {
+		t.Fatalf("PresignUpload returned error: %v", err)
+	}
+	putKey, putExpires, putSignature := signedQuery(t, putURL)
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		method      string
+		fileKey     string
+		contentType string
+		expires     string
+		signature   string
+		wantErr     bool
+	}{
+		{"get", issuedAt, "GET", getKey, "", getExpires, getSignature, false},
+		{"get ignores the content type", issuedAt, "GET", getKey, "text/plain", getExpires, getSignature, false},
+		{"put", issuedAt, "PUT", putKey, "image/jpeg", putExpires, putSignature, false},
+		{"put with another content type", issuedAt, "PUT", putKey, "image/png", putExpires, putSignature, true},
+		{"get signature used for put", issuedAt, "PUT", getKey, "", getExpires, getSignature, true},
+		{"put signature used for get", issuedAt, "GET", putKey, "", putExpires, putSignature, true},
+		{"another key", issuedAt, "GET", "posts/other/original.jpg", "", getExpires, getSignature, true},
+		{"extended expiry", issuedAt, "GET", getKey, "", getExpires + "0", getSignature, true},
+		{"invalid expiry", issuedAt, "GET", getKey, "", "tomorrow", getSignature, true},
+		{"tampered signature", issuedAt, "GET", getKey, "", getExpires, strings.Repeat("0", len(getSignature)), true},
+		{"missing signature", issuedAt, "GET", getKey, "", getExpires, "", true},
+		{"expired", issuedAt.Add(time.Minute), "PUT", putKey, "image/jpeg", putExpires, putSignature, true},
+		{"just before expiry", issuedAt.Add(time.Minute - time.Second), "PUT", putKey, "image/jpeg", putExpires, putSignature, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.now = func() time.Time { return tt.now }
+			err := repo.VerifySignature(tt.method, tt.fileKey, tt.contentType, tt.expires, tt.signature)
+			if tt.wantErr && !errors.Is(err, models.ErrInvalidFileSignature) {
+				t.Errorf("VerifySignature error = %v, want ErrInvalidFileSignature", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("VerifySignature returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestLocalStorageRepositoryVerifySignatureOtherSecret(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	fileKey, expires, signature := signedQuery(t, mustGetUrl(t, newTestLocalStorage(t, now), "posts/a/original.jpg"))
+
+	other := NewLocalStorageRepository(t.TempDir(), "http://localhost:3000", "another secret")
+	other.now = func() time.Time { return now }
+	if err := other.VerifySignature("GET", fileKey, "", expires, signature); !errors.Is(err, models.ErrInvalidFileSignature) {
+		t.Errorf("VerifySignature error = %v, want ErrInvalidFileSignature", err)
+	}
+}
+
+func mustGetUrl(t *testing.T, repo *LocalStorageRepository, fileKey string) string {
+	t.Helper()
+	rawURL, err := repo.GetUrl(fileKey)
+	if err != nil {
+		t.Fatalf("GetUrl returned error: %v", err)
+	}
+	return rawURL
+}
+
+func TestLocalStorageRepositoryFilePath(t *testing.T) {
+	repo := newTestLocalStorage(t, time.Now())
+	tests := []struct {
+		name    string
+		fileKey string
+		wantErr bool
+	}{
+		{"object", "posts/a/original.jpg", false},
+		{"upload", "uploads/b", false},
+		{"empty", "", true},
+		{"parent directory", "../secret", true},
+		{"parent directory inside the key", "posts/../../secret", true},
+		{"dot segment", "posts/./a.jpg", true},
+		{"absolute", "/etc/passwd", true},
+		{"trailing slash", "posts/a/", true},
+		{"double slash", "posts//a.jpg", true},
+		{"current directory", ".", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath, err := repo.filePath(tt.fileKey)
+			if tt.wantErr {
+				if !errors.Is(err, models.ErrUploadNotFound) {
+					t.Errorf("filePath(%q) = %q, %v, want ErrUploadNotFound", tt.fileKey, filePath, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("filePath(%q) returned error: %v", tt.fileKey, err)
+			}
+			rel, err := filepath.Rel(repo.dir, filePath)
+			if err != nil || rel != filepath.FromSlash(tt.fileKey) {
+				t.Errorf("filePath(%q) = %q, want it under %q", tt.fileKey, filePath, repo.dir)
+			}
+		})
+	}
+}
+
+func TestLocalStorageRepositoryObjects(t *testing.T) {
+	repo := newTestLocalStorage(t, time.Now())
+	outside := filepath.Join(filepath.Dir(repo.dir), "outside.jpg")
+	if err := os.WriteFile(outside, []byte("outside"), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	if err := repo.PutObject("posts/a/original.jpg", "image/jpeg", []byte("image")); err != nil {
+		t.Fatalf("PutObject returned error: %v", err)
+	}
+	data, err := repo.GetObject("posts/a/original.jpg")
+	if err != nil || string(data) != "image" {
+		t.Fatalf("GetObject = %q, %v, want %q", data, err, "image")
+	}
+	stored, err := repo.StatImage("posts/a/original.jpg")
+	if err != nil || stored.ContentType != "image/jpeg" || stored.Size != int64(len("image")) {
+		t.Fatalf("StatImage = %+v, %v", stored, err)
+	}
+
+	if _, err := repo.GetObject("../outside.jpg"); !errors.Is(err, models.ErrUploadNotFound) {
+		t.Errorf("GetObject outside the directory error = %v, want ErrUploadNotFound", err)
+	}
+	if err := repo.PutObject("../outside.jpg", "image/jpeg", []byte("overwritten")); !errors.Is(err, models.ErrUploadNotFound) {
+		t.Errorf("PutObject outside the directory error = %v, want ErrUploadNotFound", err)
+	}
+	if err := repo.DeleteImage("../outside.jpg"); !errors.Is(err, models.ErrUploadNotFound) {
+		t.Errorf("DeleteImage outside the directory error = %v, want ErrUploadNotFound", err)
+	}
+	if data, err := os.ReadFile(outside); err != nil || string(data) != "outside" {
+		t.Errorf("file outside the directory = %q, %v, want it untouched", data, err)
+	}
+
+	if err := repo.DeleteImage("posts/a/original.jpg"); err != nil {
+		t.Fatalf("DeleteImage returned error: %v", err)
+	}
+	if _, err := repo.GetObject("posts/a/original.jpg"); !errors.Is(err, models.ErrUploadNotFound) {
+		t.Errorf("GetObject after delete error = %v, want ErrUploadNotFound", err)
+	}
+	if err := repo.DeleteImage("posts/a/original.jpg"); err != nil {
+		t.Errorf("DeleteImage of a missing file returned error: %v", err)
+	}
+}
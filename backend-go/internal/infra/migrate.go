@@ -0,0 +1,71 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+)
+
+// uniqueIndexCleanup removes the rows that would keep a unique index from being created on existing data
+type uniqueIndexCleanup struct {
+	index string
+	query string
+}
+
+var uniqueIndexCleanups = []uniqueIndexCleanup{
+	// フォローが冪等になる前は同じフォローが重複して保存されていた。最も古い関係だけを残す
+	{
+		index: "followrelation_user_following_user_followers",
+		query: fmt.Sprintf(
+			`DELETE FROM %[1]s AS a USING %[1]s AS b WHERE a.%[2]s = b.%[2]s AND a.%[3]s = b.%[3]s AND (a.created_at, a.id) > (b.created_at, b.id)`,
+			followrelation.Table, followrelation.FromColumn, followrelation.ToColumn,
+		),
+	},
+	// 未読の通知がまとまらずに重複していた場合は、最新のもの以外を既読にする
+	{
+		index: "notification_unread_post",
+		query: fmt.Sprintf(
+			`UPDATE %[1]s AS a SET read = true FROM %[1]s AS b WHERE a.read = false AND b.read = false AND a.%[2]s = b.%[2]s AND a.kind = b.kind AND a.%[3]s = b.%[3]s AND (a.updated_at, a.id) < (b.updated_at, b.id)`,
+			notification.Table, notification.RecipientColumn, notification.PostColumn,
+		),
+	},
+	{
+		index: "notification_unread_follow",
+		query: fmt.Sprintf(
+			`UPDATE %[1]s AS a SET read = true FROM %[1]s AS b WHERE a.read = false AND b.read = false AND a.kind = 'follow' AND b.kind = 'follow' AND a.%[2]s = b.%[2]s AND (a.updated_at, a.id) < (b.updated_at, b.id)`,
+			notification.Table, notification.RecipientColumn,
+		),
+	},
+}
+
+// MigrateSchema runs the auto migration. When it is about to create one of the unique indexes above,
+// the rows that violate it are removed first, in the same transaction, so that the migration does not fail.
+func MigrateSchema(ctx context.Context, client *ent.Client) error {
+	return client.Schema.Create(ctx, schema.WithApplyHook(cleanUpBeforeUniqueIndexes))
+}
+
+func cleanUpBeforeUniqueIndexes(next schema.Applier) schema.Applier {
+	return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+		changes := make([]*migrate.Change, 0, len(plan.Changes))
+		for _, change := range plan.Changes {
+			for _, cleanup := range uniqueIndexCleanups {
+				if strings.HasPrefix(change.Cmd, "CREATE UNIQUE INDEX") && strings.Contains(change.Cmd, `"`+cleanup.index+`"`) {
+					changes = append(changes, &migrate.Change{
+						Cmd:     cleanup.query,
+						Comment: fmt.Sprintf("remove rows that violate index %q", cleanup.index),
+					})
+				}
+			}
+			changes = append(changes, change)
+		}
+		plan.Changes = changes
+		return next.Apply(ctx, conn, plan)
+	})
+}
@@ -0,0 +1,142 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type NotificationRepository struct {
+	db *ent.Client
+}
+
+func NewNotificationRepository(db *ent.Client) *NotificationRepository {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+func (r *NotificationRepository) Record(kind notification.Kind, actorId, recipientId, postId string) error {
+	actorUUID, err := uuid.Parse(actorId)
+	if err != nil {
+		return err
+	}
+	recipientUUID, err := uuid.Parse(recipientId)
+	if err != nil {
+		return err
+	}
+	var postUUID *uuid.UUID
+	// 未読の通知をまとめる部分ユニークインデックス (ent/schema/notification.go) と同じ条件
+	conflictColumns := []string{notification.FieldUserNotifications}
+	conflictWhere := sql.ExprP("read = false AND kind = 'follow'")
+	if postId != "" {
+		parsed, err := uuid.Parse(postId)
+		if err != nil {
+			return err
+		}
+		postUUID = &parsed
+		conflictColumns = []string{notification.FieldUserNotifications, notification.FieldKind, notification.PostColumn}
+		conflictWhere = sql.ExprP("read = false AND notification_post IS NOT NULL")
+	}
+
+	// 未読の同じ通知があればそこにまとめる。アクターは重複させずに先頭へ追加する。
+	// 同時に記録されてもインデックスに対する upsert なので 1 件にまとまる
+	now := time.Now()
+	return r.db.Notification.Create().
+		SetKind(kind).
+		SetActorIds([]uuid.UUID{actorUUID}).
+		SetRecipientID(recipientUUID).
+		SetActorID(actorUUID).
+		SetNillablePostID(postUUID).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		OnConflict(
+			sql.ConflictColumns(conflictColumns...),
+			sql.ConflictWhere(conflictWhere),
+			sql.ResolveWith(func(u *sql.UpdateSet) {
+				u.SetExcluded(notification.ActorColumn)
+				u.SetExcluded(notification.FieldUpdatedAt)
+				current := u.Table().C(notification.FieldActorIds)
+				excluded := sql.Dialect(dialect.Postgres).Table("excluded").C(notification.FieldActorIds)
+				u.Set(notification.FieldActorIds, sql.Expr(fmt.Sprintf("CASE WHEN %[1]s @> %[2]s THEN %[1]s ELSE %[2]s || %[1]s END", current, excluded)))
+			}),
+		).
+		Exec(context.Background())
+}
+
+func (r *NotificationRepository) List(recipientId string, cursor *models.Cursor, limit int) ([]*ent.Notification, error) {
+	recipientUUID, err := uuid.Parse(recipientId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.Notification.Query().
+		Where(notification.UserNotifications(recipientUUID))
+	// 通知のカーソルは updated_at を CreatedAt に入れて使う
+	if cursor != nil {
+		query = query.Where(notification.Or(
+			notification.UpdatedAtLT(cursor.CreatedAt),
+			notification.And(notification.UpdatedAtEQ(cursor.CreatedAt), notification.IDLT(cursor.ID)),
+		))
+	}
+	return query.
+		WithActor().
+		// 削除された投稿は読み込まず、サムネイルを出さない
+		WithPost(func(q *ent.PostQuery) {
+			q.Where(post.DeletedAtIsNil()).Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt)
+		}).
+		Order(notification.ByUpdatedAt(sql.OrderDesc()), notification.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(context.Background())
+}
+
+func (r *NotificationRepository) CountUnread(recipientId string) (int, error) {
+	recipientUUID, err := uuid.Parse(recipientId)
+	if err != nil {
+		return 0, err
+	}
+	return r.db.Notification.Query().
+		Where(
+			notification.UserNotifications(recipientUUID),
+			notification.Read(false),
+		).
+		Count(context.Background())
+}
+
+func (r *NotificationRepository) MarkRead(recipientId, notificationId string) error {
+	notificationUUID, err := uuid.Parse(notificationId)
+	if err != nil {
+		return err
+	}
+	recipientUUID, err := uuid.Parse(recipientId)
+	if err != nil {
+		return err
+	}
+	// 他人の通知は条件に合わず、NotFoundError になる
+	return r.db.Notification.UpdateOneID(notificationUUID).
+		Where(notification.UserNotifications(recipientUUID)).
+		SetRead(true).
+		Exec(context.Background())
+}
+
+func (r *NotificationRepository) MarkAllRead(recipientId string) error {
+	recipientUUID, err := uuid.Parse(recipientId)
+	if err != nil {
+		return err
+	}
+	return r.db.Notification.Update().
+		Where(
+			notification.UserNotifications(recipientUUID),
+			notification.Read(false),
+		).
+		SetRead(true).
+		Exec(context.Background())
+}
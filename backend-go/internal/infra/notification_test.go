@@ -0,0 +1,63 @@
+package infra
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/google/uuid"
+)
+
+func TestNotificationRepositoryRecord(t *testing.T) {
+	actorIds := `"actor_ids" = CASE WHEN "notifications"."actor_ids" @> "excluded"."actor_ids" THEN "notifications"."actor_ids" ELSE "excluded"."actor_ids" || "notifications"."actor_ids" END`
+	tests := []struct {
+		name    string
+		kind    notification.Kind
+		postId  string
+		wantSQL []string
+	}{
+		{
+			name:   "like",
+			kind:   notification.KindLike,
+			postId: uuid.NewString(),
+			wantSQL: []string{
+				`ON CONFLICT ("user_notifications", "kind", "notification_post") WHERE read = false AND notification_post IS NOT NULL DO UPDATE SET`,
+				`"notification_actor" = "excluded"."notification_actor"`,
+				`"updated_at" = "excluded"."updated_at"`,
+				actorIds,
+			},
+		},
+		{
+			name: "follow",
+			kind: notification.KindFollow,
+			wantSQL: []string{
+				`ON CONFLICT ("user_notifications") WHERE read = false AND kind = 'follow' DO UPDATE SET`,
+				actorIds,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drv := &recordingDriver{}
+			repo := NewNotificationRepository(ent.NewClient(ent.Driver(drv)))
+
+			err := repo.Record(tt.kind, uuid.NewString(), uuid.NewString(), tt.postId)
+			if !errors.Is(err, errQueryRecorded) {
+				t.Fatalf("Record error = %v, want the recorded query error", err)
+			}
+			if len(drv.queries) != 1 {
+				t.Fatalf("Record sent %d queries, want a single upsert", len(drv.queries))
+			}
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(drv.queries[0], want) {
+					t.Errorf("query does not contain %q:\n%s", want, drv.queries[0])
+				}
+			}
+			if strings.Contains(drv.queries[0], `"created_at" = "excluded"`) {
+				t.Errorf("query moves created_at of the collapsed notification:\n%s", drv.queries[0])
+			}
+		})
+	}
+}
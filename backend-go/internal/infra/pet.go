@@ -19,6 +19,22 @@ func NewPetRepository(db *ent.Client) *PetRepository {
 	}
 }
 
+func (r *PetRepository) GetById(petID string) (*ent.Pet, error) {
+	petUUID, err := uuid.Parse(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	pet, err := r.db.Pet.Query().
+		Where(pet.ID(petUUID)).
+		WithOwner().
+		Only(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return pet, nil
+}
+
 func (r *PetRepository) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	ownerUUID, err := uuid.Parse(ownerID)
 	if err != nil {
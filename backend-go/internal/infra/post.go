@@ -2,12 +2,22 @@ package infra
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
+	"github.com/pgvector/pgvector-go"
 )
 
 type PostRepository struct {
@@ -23,6 +33,7 @@ func NewPostRepository(db *ent.Client) *PostRepository {
 func (r *PostRepository) GetAllPosts() ([]*ent.Post, error) {
 	posts, err := r.db.Post.Query().
 		WithUser().
+		WithDailyTask().
 		Where(post.DeletedAtIsNil()).
 		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
 		All(context.Background())
@@ -33,9 +44,218 @@ func (r *PostRepository) GetAllPosts() ([]*ent.Post, error) {
 	return posts, nil
 }
 
+func (r *PostRepository) GetById(postID string) (*ent.Post, error) {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := r.db.Post.Query().
+		Where(post.ID(postUUID)).
+		Where(post.DeletedAtIsNil()).
+		WithUser().
+		WithDailyTask().
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Only(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// GetByIndexes returns the posts with the given indexes in the same order, skipping deleted posts
+func (r *PostRepository) GetByIndexes(indexes []int) ([]*ent.Post, error) {
+	posts, err := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		Where(
+			post.IndexIn(indexes...),
+			post.DeletedAtIsNil(),
+		).
+		Select(post.FieldID, post.FieldIndex, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get posts by indexes: %v", err)
+		return nil, err
+	}
+
+	byIndex := make(map[int]*ent.Post, len(posts))
+	for _, p := range posts {
+		byIndex[p.Index] = p
+	}
+	ordered := make([]*ent.Post, 0, len(posts))
+	for _, index := range indexes {
+		if p, ok := byIndex[index]; ok {
+			ordered = append(ordered, p)
+		}
+	}
+	return ordered, nil
+}
+
+func (r *PostRepository) GetTimeline(cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	posts, err := r.timelineQuery(cursor, limit).All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get timeline: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetFollowingTimeline returns posts by the user and by the users they follow
+func (r *PostRepository) GetFollowingTimeline(userID string, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := r.timelineQuery(cursor, limit,
+		post.HasUserWith(user.Or(
+			user.ID(userUUID),
+			user.HasFollowersWith(followrelation.HasFromWith(user.ID(userUUID))),
+		)),
+	).All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get following timeline: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetByTag returns the posts with the tag, newest first
+func (r *PostRepository) GetByTag(tagName string, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	posts, err := r.timelineQuery(cursor, limit, post.HasTagsWith(tag.Name(tagName))).All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get posts by tag: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// timelineQuery orders posts newest first by (created_at, id) and starts after the cursor
+func (r *PostRepository) timelineQuery(cursor *models.Cursor, limit int, preds ...predicate.Post) *ent.PostSelect {
+	query := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		Where(post.DeletedAtIsNil()).
+		Where(preds...)
+	if cursor != nil {
+		query = query.Where(post.Or(
+			post.CreatedAtLT(cursor.CreatedAt),
+			post.And(post.CreatedAtEQ(cursor.CreatedAt), post.IDLT(cursor.ID)),
+		))
+	}
+	return query.
+		Order(post.ByCreatedAt(sql.OrderDesc()), post.ByID(sql.OrderDesc())).
+		Limit(limit).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt)
+}
+
+// GetSimilarPosts returns the posts whose image feature is closest to that of the given post by cosine distance.
+// Posts by excludeUserID are left out. Nothing is returned while the feature of the post has not been computed.
+func (r *PostRepository) GetSimilarPosts(postID, excludeUserID string, limit int) ([]*ent.Post, error) {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := r.db.Post.Query().
+		Where(
+			post.ID(postUUID),
+			post.ImageFeatureNotNil(),
+		).
+		Select(post.FieldImageFeature).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	preds := []predicate.Post{
+		post.IDNEQ(postUUID),
+		post.DeletedAtIsNil(),
+		post.ImageFeatureNotNil(),
+	}
+	if excludeUserID != "" {
+		excludeUserUUID, err := uuid.Parse(excludeUserID)
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, post.Not(post.HasUserWith(user.ID(excludeUserUUID))))
+	}
+
+	posts, err := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		Where(preds...).
+		Limit(limit).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Modify(func(s *sql.Selector) {
+			// pgvector のコサイン距離演算子
+			s.OrderExpr(sql.P(func(b *sql.Builder) {
+				b.WriteString(s.C(post.FieldImageFeature)).WriteString(" <=> ").Arg(target.ImageFeature)
+			}))
+		}).
+		All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get similar posts: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// captionMatchBoost is added to the image similarity (0〜1) of posts whose caption contains the keyword
+const captionMatchBoost = 0.3
+
+// SearchPosts ranks posts by the cosine similarity between their image feature and the query embedding,
+// boosting posts whose caption contains the keyword. Without an embedding only caption matches are returned.
+func (r *PostRepository) SearchPosts(keyword string, embedding *pgvector.Vector, limit int) ([]*ent.Post, error) {
+	query := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		Where(post.DeletedAtIsNil())
+	if embedding == nil {
+		query = query.Where(post.CaptionContainsFold(keyword))
+	} else {
+		query = query.Where(post.Or(
+			post.CaptionContainsFold(keyword),
+			post.ImageFeatureNotNil(),
+		))
+	}
+
+	posts, err := query.
+		Limit(limit).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Modify(func(s *sql.Selector) {
+			s.OrderExpr(sql.P(func(b *sql.Builder) {
+				b.WriteString("CASE WHEN ").
+					Join(sql.ContainsFold(s.C(post.FieldCaption), keyword)).
+					WriteString(fmt.Sprintf(" THEN %g ELSE 0 END", captionMatchBoost))
+				if embedding != nil {
+					// pgvector のコサイン距離を類似度に変換する。特徴量のない投稿は 0
+					b.WriteString(" + COALESCE(1 - (").
+						WriteString(s.C(post.FieldImageFeature)).
+						WriteString(" <=> ").
+						Arg(*embedding).
+						WriteString("), 0)")
+				}
+				b.WriteString(" DESC")
+			}))
+			s.OrderBy(sql.Desc(s.C(post.FieldCreatedAt)))
+		}).
+		All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to search posts: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
 func (r *PostRepository) GetPostsByUser(userID uuid.UUID) ([]*ent.Post, error) {
 	posts, err := r.db.Post.Query().
 		WithUser().
+		WithDailyTask().
 		Where(post.HasUserWith(user.ID(userID))).
 		Where(post.DeletedAtIsNil()).
 		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
@@ -47,56 +267,169 @@ func (r *PostRepository) GetPostsByUser(userID uuid.UUID) ([]*ent.Post, error) {
 	return posts, nil
 }
 
-func (r *PostRepository) CreatePost(caption, userID, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	userUUID, err := uuid.Parse(userID)
+func (r *PostRepository) GetRecentPostsByUser(userID uuid.UUID, limit int) ([]*ent.Post, error) {
+	posts, err := r.timelineQuery(nil, limit, post.HasUserWith(user.ID(userID))).All(context.Background())
 	if err != nil {
+		log.Errorf("Failed to get recent posts by user: %v", err)
 		return nil, err
 	}
+	return posts, nil
+}
 
-	postCount, err := r.db.Post.Query().Count(context.Background())
+func (r *PostRepository) CreatePost(caption, userID, fileKey string, tags []string) (*ent.Post, error) {
+	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	postCreate := r.db.Post.Create().
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, err := allocateIndex(ctx, tx, PostIndexCounter)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	tagIDs, err := upsertTags(ctx, tx, tags)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	post, err := tx.Post.Create().
 		SetCaption(caption).
 		SetImageKey(fileKey).
 		SetUserID(userUUID).
-		SetIndex(postCount)
+		SetIndex(index).
+		AddTagIDs(tagIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return post.Unwrap(), nil
+}
 
-	if dailyTaskId != nil {
-		dailyTaskUUID, err := uuid.Parse(*dailyTaskId)
-		if err != nil {
-			return nil, err
-		}
-		postCreate = postCreate.SetDailyTaskID(dailyTaskUUID)
+// RecordTaskScore stores the task score on the post and, when completed, links the post to the daily task
+func (r *PostRepository) RecordTaskScore(postId, dailyTaskId string, score float64, completed bool) error {
+	postUUID, err := uuid.Parse(postId)
+	if err != nil {
+		return err
+	}
+	dailyTaskUUID, err := uuid.Parse(dailyTaskId)
+	if err != nil {
+		return err
 	}
 
-	post, err := postCreate.Save(context.Background())
+	tx, err := r.db.Tx(context.Background())
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if err := tx.Post.UpdateOneID(postUUID).
+		SetTaskScore(score).
+		Exec(context.Background()); err != nil {
+		return rollback(tx, err)
 	}
 
-	return post, nil
+	if completed {
+		// 同じタスクへの同時投稿を防ぐため、まだ投稿の紐づいていないタスクだけを更新する
+		linked, err := tx.DailyTask.Update().
+			Where(
+				dailytask.ID(dailyTaskUUID),
+				dailytask.Not(dailytask.HasPost()),
+			).
+			SetPostID(postUUID).
+			Save(context.Background())
+		if err != nil {
+			return rollback(tx, err)
+		}
+		if linked == 0 {
+			return rollback(tx, models.ErrDailyTaskCompleted)
+		}
+	}
+
+	return tx.Commit()
 }
 
-func (r *PostRepository) UpdatePost(postID, caption string) error {
+// UpdatePost replaces the caption and the tags of the post
+func (r *PostRepository) UpdatePost(postID, caption string, tags []string) error {
 	postUUID, err := uuid.Parse(postID)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.db.Post.UpdateOneID(postUUID).
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	tagIDs, err := upsertTags(ctx, tx, tags)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = tx.Post.UpdateOneID(postUUID).
 		SetCaption(caption).
-		Save(context.Background())
-	return err
+		ClearTags().
+		AddTagIDs(tagIDs...).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
 }
 
+// DeletePost soft-deletes the post. The image is removed later by the reaper.
 func (r *PostRepository) DeletePost(postID string) error {
 	postUUID, err := uuid.Parse(postID)
 	if err != nil {
 		return err
 	}
 
-	return r.db.Post.DeleteOneID(postUUID).Exec(context.Background())
+	return r.db.Post.UpdateOneID(postUUID).
+		Where(post.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(context.Background())
+}
+
+// GetDeletedPostsWithImage returns posts deleted before the given time whose image is still stored
+// GetDeletedPostsWithImage returns the posts whose image is still to be deleted, fewest failed attempts first,
+// so that images that keep failing do not hold back the others
+func (r *PostRepository) GetDeletedPostsWithImage(deletedBefore time.Time, limit int) ([]*ent.Post, error) {
+	posts, err := r.db.Post.Query().
+		Where(
+			post.DeletedAtLT(deletedBefore),
+			post.ImageDeletedAtIsNil(),
+		).
+		Order(post.ByImageReapAttempts(), post.ByDeletedAt()).
+		Limit(limit).
+		Select(post.FieldID, post.FieldImageKey, post.FieldDeletedAt, post.FieldImageReapAttempts).
+		All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get deleted posts: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) MarkImageDeleted(postID string) error {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Post.UpdateOneID(postUUID).
+		SetImageDeletedAt(time.Now()).
+		Exec(context.Background())
+}
+
+func (r *PostRepository) RecordImageReapFailure(postID string) error {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Post.UpdateOneID(postUUID).
+		AddImageReapAttempts(1).
+		Exec(context.Background())
 }
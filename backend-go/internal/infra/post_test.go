@@ -0,0 +1,107 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/pgvector/pgvector-go"
+)
+
+var errQueryRecorded = errors.New("query recorded")
+
+// recordingDriver records the queries sent to Postgres and fails them, so that the SQL built by a
+// repository can be checked without a database
+type recordingDriver struct {
+	queries []string
+	args    [][]any
+}
+
+func (d *recordingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.record(query, args)
+}
+
+func (d *recordingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.record(query, args)
+}
+
+func (d *recordingDriver) record(query string, args any) error {
+	d.queries = append(d.queries, query)
+	values, _ := args.([]any)
+	d.args = append(d.args, values)
+	return errQueryRecorded
+}
+
+func (d *recordingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return dialect.NopTx(d), nil
+}
+
+func (d *recordingDriver) Close() error { return nil }
+
+func (d *recordingDriver) Dialect() string { return dialect.Postgres }
+
+func TestPostRepositorySearchPosts(t *testing.T) {
+	stub := NewStubEmbeddingRepository()
+	dogEmbedding, err := stub.EmbedText("dog")
+	if err != nil {
+		t.Fatalf("EmbedText returned error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		keyword   string
+		embedding *pgvector.Vector
+		wantSQL   []string
+		wantNoSQL []string
+	}{
+		{
+			name:      "caption only",
+			keyword:   "dog",
+			wantSQL:   []string{`"posts"."caption" ILIKE`, `"posts"."deleted_at" IS NULL`},
+			wantNoSQL: []string{"<=>", `"image_feature" IS NOT NULL`},
+		},
+		{
+			name:      "caption and stub embedding",
+			keyword:   "dog",
+			embedding: &dogEmbedding,
+			wantSQL:   []string{`"posts"."caption" ILIKE`, `"posts"."image_feature" IS NOT NULL`, `"posts"."image_feature" <=> $`, "COALESCE(1 - ("},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drv := &recordingDriver{}
+			repo := NewPostRepository(ent.NewClient(ent.Driver(drv)))
+
+			if _, err := repo.SearchPosts(tt.keyword, tt.embedding, 10); !errors.Is(err, errQueryRecorded) {
+				t.Fatalf("SearchPosts error = %v, want the recorded query error", err)
+			}
+			if len(drv.queries) != 1 {
+				t.Fatalf("SearchPosts sent %d queries, want 1", len(drv.queries))
+			}
+			query := drv.queries[0]
+			for _, want := range tt.wantSQL {
+				if !strings.Contains(query, want) {
+					t.Errorf("query does not contain %q:\n%s", want, query)
+				}
+			}
+			for _, unwanted := range tt.wantNoSQL {
+				if strings.Contains(query, unwanted) {
+					t.Errorf("query contains %q:\n%s", unwanted, query)
+				}
+			}
+
+			var boundEmbedding bool
+			for _, arg := range drv.args[0] {
+				if vector, ok := arg.(pgvector.Vector); ok {
+					boundEmbedding = tt.embedding != nil && equalVectors(vector, *tt.embedding)
+				}
+			}
+			if boundEmbedding != (tt.embedding != nil) {
+				t.Errorf("embedding bound as an argument = %v, want %v (args %v)", boundEmbedding, tt.embedding != nil, drv.args[0])
+			}
+		})
+	}
+}
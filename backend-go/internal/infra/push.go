@@ -0,0 +1,169 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+const (
+	// ExpoPushURL is the endpoint of the Expo push service
+	ExpoPushURL = "https://exp.host/--/api/v2/push/send"
+	// expoPushTimeout is short because on Lambda notifications are pushed within the like, comment or follow request
+	expoPushTimeout = 5 * time.Second
+	// expoPushBatchSize is the largest number of messages Expo accepts in one request
+	expoPushBatchSize = 100
+)
+
+// ExpoPushSender sends push notifications through the Expo push service
+type ExpoPushSender struct {
+	url         string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// NewExpoPushSender takes the access token of the Expo project, which is only needed when enhanced push security is enabled
+func NewExpoPushSender(url, accessToken string) *ExpoPushSender {
+	return &ExpoPushSender{
+		url:         url,
+		accessToken: accessToken,
+		httpClient:  &http.Client{Timeout: expoPushTimeout},
+	}
+}
+
+type expoPushMessage struct {
+	To    string            `json:"to"`
+	Title string            `json:"title"`
+	Body  string            `json:"body"`
+	Data  map[string]string `json:"data,omitempty"`
+	Sound string            `json:"sound"`
+}
+
+// expoPushTicket is the result of one message, in the order the messages were sent
+type expoPushTicket struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Details struct {
+		Error string `json:"error"`
+	} `json:"details"`
+}
+
+func (s *ExpoPushSender) Send(messages []models.PushMessage) ([]string, error) {
+	var invalidTokens []string
+	for start := 0; start < len(messages); start += expoPushBatchSize {
+		batch := messages[start:min(start+expoPushBatchSize, len(messages))]
+		invalid, err := s.sendBatch(batch)
+		if err != nil {
+			return invalidTokens, err
+		}
+		invalidTokens = append(invalidTokens, invalid...)
+	}
+	return invalidTokens, nil
+}
+
+func (s *ExpoPushSender) sendBatch(messages []models.PushMessage) ([]string, error) {
+	payload := make([]expoPushMessage, len(messages))
+	for i, message := range messages {
+		payload[i] = expoPushMessage{
+			To:    message.Token,
+			Title: message.Title,
+			Body:  message.Body,
+			Data:  message.Data,
+			Sound: "default",
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if s.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.accessToken)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Expo push service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Expo push service returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Data []expoPushTicket `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode push tickets: %w", err)
+	}
+
+	var invalidTokens []string
+	for i, ticket := range result.Data {
+		if ticket.Status != "error" || i >= len(messages) {
+			continue
+		}
+		// アプリが削除された端末のトークンは以降も届かないので、呼び出し側で削除させる
+		if ticket.Details.Error == "DeviceNotRegistered" {
+			invalidTokens = append(invalidTokens, messages[i].Token)
+			continue
+		}
+		log.Printf("Failed to send push notification: %s (%s)", ticket.Message, ticket.Details.Error)
+	}
+	return invalidTokens, nil
+}
+
+// FakePushSender keeps sent messages in memory, for local development and tests without Expo
+type FakePushSender struct {
+	mu            sync.Mutex
+	sent          []models.PushMessage
+	invalidTokens map[string]bool
+}
+
+func NewFakePushSender() *FakePushSender {
+	return &FakePushSender{
+		invalidTokens: map[string]bool{},
+	}
+}
+
+// MarkInvalid makes Send report the token as no longer registered
+func (s *FakePushSender) MarkInvalid(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.invalidTokens[token] = true
+}
+
+// Sent returns the messages delivered so far
+func (s *FakePushSender) Sent() []models.PushMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]models.PushMessage(nil), s.sent...)
+}
+
+func (s *FakePushSender) Send(messages []models.PushMessage) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var invalidTokens []string
+	for _, message := range messages {
+		if s.invalidTokens[message.Token] {
+			invalidTokens = append(invalidTokens, message.Token)
+			continue
+		}
+		log.Printf("Push notification to %s: %s %s", message.Token, message.Title, message.Body)
+		s.sent = append(s.sent, message)
+	}
+	return invalidTokens, nil
+}
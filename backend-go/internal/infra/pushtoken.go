@@ -0,0 +1,78 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/pushtoken"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+type PushTokenRepository struct {
+	db *ent.Client
+}
+
+func NewPushTokenRepository(db *ent.Client) *PushTokenRepository {
+	return &PushTokenRepository{
+		db: db,
+	}
+}
+
+func (r *PushTokenRepository) Register(userId, token, platform string) error {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return err
+	}
+
+	// 同じ端末で別のユーザーがログインしていた場合は、そのユーザーから付け替える
+	return r.db.PushToken.Create().
+		SetUserID(userUUID).
+		SetToken(token).
+		SetPlatform(platform).
+		OnConflictColumns(pushtoken.FieldToken).
+		Update(func(u *ent.PushTokenUpsert) {
+			u.Set(pushtoken.UserColumn, userUUID)
+			u.SetPlatform(platform)
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(context.Background())
+}
+
+func (r *PushTokenRepository) Unregister(userId, token string) error {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.PushToken.Delete().
+		Where(
+			pushtoken.Token(token),
+			pushtoken.HasUserWith(user.ID(userUUID)),
+		).
+		Exec(context.Background())
+	return err
+}
+
+func (r *PushTokenRepository) ListByUser(userId string) ([]string, error) {
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+	return r.db.PushToken.Query().
+		Where(pushtoken.HasUserWith(user.ID(userUUID))).
+		Order(pushtoken.ByUpdatedAt(sql.OrderDesc())).
+		Select(pushtoken.FieldToken).
+		Strings(context.Background())
+}
+
+func (r *PushTokenRepository) DeleteTokens(tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	_, err := r.db.PushToken.Delete().
+		Where(pushtoken.TokenIn(tokens...)).
+		Exec(context.Background())
+	return err
+}
@@ -0,0 +1,63 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// recommendationTimeout is kept short because the chronological timeline is served when it runs out
+const recommendationTimeout = 3 * time.Second
+
+// RecommendationRepository calls the recommendation service (algorithm/recommend_system)
+type RecommendationRepository struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewRecommendationRepository(baseURL string) *RecommendationRepository {
+	return &RecommendationRepository{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: recommendationTimeout},
+	}
+}
+
+func (r *RecommendationRepository) RecommendPostIndexes(userIndex, offset, limit int) ([]int, error) {
+	body, err := json.Marshal(map[string]int{
+		"user_id": userIndex,
+		"offset":  offset,
+		"limit":   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.httpClient.Post(r.baseURL+"/timeline", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call recommendation service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("recommendation service returned status %d", resp.StatusCode)
+	}
+
+	// 投稿の内容は ent から取り直すため、ID (Post.index) だけを読む
+	var result struct {
+		Posts []struct {
+			ID int `json:"id"`
+		} `json:"posts"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode recommended timeline: %w", err)
+	}
+
+	indexes := make([]int, len(result.Posts))
+	for i, post := range result.Posts {
+		indexes[i] = post.ID
+	}
+	return indexes, nil
+}
@@ -3,75 +3,125 @@ package infra
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
-	"mime/multipart"
 	"os"
-	"path/filepath"
 	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/uuid"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
+// PresignedURLExpiry is how long the URLs returned by GetUrl stay valid
+const PresignedURLExpiry = time.Hour
+
 type S3Repository struct {
 	s3Client   *s3.Client
 	bucketName string
 }
 
-func NewS3Repository(bucketName string) *S3Repository {
+// NewS3Repository connects to the bucket on AWS, or on an S3 compatible server such as MinIO when endpoint is set.
+// MinIO usually needs usePathStyle, since its buckets are not resolvable as subdomains.
+func NewS3Repository(bucketName, endpoint string, usePathStyle bool) *S3Repository {
 	region := os.Getenv("AWS_REGION")
 	accessKeyId := os.Getenv("AWS_ACCESS_KEY_ID")
 	secretAccessKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+	options := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if accessKeyId != "" {
+		options = append(options, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
 			accessKeyId,
 			secretAccessKey,
 			"",
-		)),
-	)
+		)))
+	}
+	cfg, err := config.LoadDefaultConfig(context.TODO(), options...)
 	if err != nil {
 		log.Fatalf("Failed to load AWS config: %v", err)
 	}
-	s3Client := s3.NewFromConfig(cfg)
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		o.UsePathStyle = usePathStyle
+	})
 	return &S3Repository{
 		s3Client:   s3Client,
 		bucketName: bucketName,
 	}
 }
 
-func (r *S3Repository) UploadImage(file *multipart.FileHeader, directory string) (string, error) {
-	src, err := file.Open()
+func (r *S3Repository) PutObject(fileKey, contentType string, data []byte) error {
+	_, err := r.s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:      aws.String(r.bucketName),
+		Key:         aws.String(fileKey),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to open file: %w", err)
+		return fmt.Errorf("failed to upload file to S3: %w", err)
 	}
-	defer src.Close()
 
-	// Read the file content
-	buffer := make([]byte, file.Size)
-	if _, err := src.Read(buffer); err != nil && err != io.EOF {
-		return "", fmt.Errorf("failed to read file: %w", err)
+	return nil
+}
+
+func (r *S3Repository) GetObject(fileKey string) ([]byte, error) {
+	output, err := r.s3Client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(fileKey),
+	})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return nil, models.ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object from S3: %w", err)
 	}
+	defer output.Body.Close()
 
-	fileKey := fmt.Sprintf("%s/%s-%s", directory, uuid.New().String(), filepath.Base(file.Filename))
+	data, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object from S3: %w", err)
+	}
+	return data, nil
+}
 
-	// Upload the file to S3
-	_, err = r.s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
+func (r *S3Repository) PresignUpload(fileKey, contentType string, expires time.Duration) (string, error) {
+	presigner := s3.NewPresignClient(r.s3Client)
+	presignedURL, err := presigner.PresignPutObject(context.TODO(), &s3.PutObjectInput{
 		Bucket:      aws.String(r.bucketName),
 		Key:         aws.String(fileKey),
-		Body:        bytes.NewReader(buffer),
-		ContentType: aws.String(file.Header.Get("Content-Type")),
+		ContentType: aws.String(contentType),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned upload URL: %w", err)
+	}
+
+	return presignedURL.URL, nil
+}
+
+func (r *S3Repository) StatImage(fileKey string) (*models.StoredObject, error) {
+	output, err := r.s3Client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(fileKey),
 	})
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return nil, models.ErrUploadNotFound
+	}
 	if err != nil {
-		return "", fmt.Errorf("failed to upload file to S3: %w", err)
+		return nil, fmt.Errorf("failed to get object metadata: %w", err)
 	}
 
-	return fileKey, nil
+	return &models.StoredObject{
+		ContentType: aws.ToString(output.ContentType),
+		Size:        aws.ToInt64(output.ContentLength),
+	}, nil
 }
 
 func (r *S3Repository) GetUrl(fileKey string) (string, error) {
@@ -79,7 +129,7 @@ func (r *S3Repository) GetUrl(fileKey string) (string, error) {
 	presignedURL, err := presigner.PresignGetObject(context.TODO(), &s3.GetObjectInput{
 		Bucket: aws.String(r.bucketName),
 		Key:    aws.String(fileKey),
-	}, s3.WithPresignExpires(time.Hour))
+	}, s3.WithPresignExpires(PresignedURLExpiry))
 	if err != nil {
 		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
 	}
@@ -0,0 +1,81 @@
+package infra
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// maxCachedURLs bounds the memory used by the URL cache; expired entries are dropped first when it is reached
+const maxCachedURLs = 10000
+
+type cachedURL struct {
+	url       string
+	expiresAt time.Time
+}
+
+// CachedStorageRepository reuses the signed URL of an object until shortly before it expires,
+// so that list responses return the same URL across requests and the client cache can hit
+type CachedStorageRepository struct {
+	repository.StorageRepository
+	ttl   time.Duration
+	now   func() time.Time
+	mu    sync.Mutex
+	cache map[string]cachedURL
+}
+
+// NewCachedStorageRepository caches the URLs returned by storage, which are valid for urlExpiry.
+// A URL is handed out for the first 5/6 of its lifetime so that clients still get some time to load it
+func NewCachedStorageRepository(storage repository.StorageRepository, urlExpiry time.Duration) *CachedStorageRepository {
+	return &CachedStorageRepository{
+		StorageRepository: storage,
+		ttl:               urlExpiry - urlExpiry/6,
+		now:               time.Now,
+		cache:             make(map[string]cachedURL),
+	}
+}
+
+func (r *CachedStorageRepository) GetUrl(fileKey string) (string, error) {
+	now := r.now()
+
+	r.mu.Lock()
+	cached, ok := r.cache[fileKey]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.url, nil
+	}
+
+	url, err := r.StorageRepository.GetUrl(fileKey)
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.cache) >= maxCachedURLs {
+		r.evictExpired(now)
+	}
+	r.cache[fileKey] = cachedURL{url: url, expiresAt: now.Add(r.ttl)}
+	return url, nil
+}
+
+func (r *CachedStorageRepository) DeleteImage(fileKey string) error {
+	r.mu.Lock()
+	delete(r.cache, fileKey)
+	r.mu.Unlock()
+	return r.StorageRepository.DeleteImage(fileKey)
+}
+
+// evictExpired drops expired entries, and everything when the cache is still full afterwards.
+// The caller must hold r.mu
+func (r *CachedStorageRepository) evictExpired(now time.Time) {
+	for key, cached := range r.cache {
+		if !now.Before(cached.expiresAt) {
+			delete(r.cache, key)
+		}
+	}
+	if len(r.cache) >= maxCachedURLs {
+		r.cache = make(map[string]cachedURL)
+	}
+}
@@ -0,0 +1,124 @@
+package infra
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// objectURL joins the base URL (e.g. a CDN domain in front of the bucket) and the escaped object key
+func objectURL(baseURL, fileKey string) string {
+	segments := strings.Split(fileKey, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return baseURL + "/" + strings.Join(segments, "/")
+}
+
+// PublicURLStorageRepository returns plain URLs under a public base URL instead of presigned ones
+type PublicURLStorageRepository struct {
+	repository.StorageRepository
+	baseURL string
+}
+
+func NewPublicURLStorageRepository(storage repository.StorageRepository, baseURL string) *PublicURLStorageRepository {
+	return &PublicURLStorageRepository{
+		StorageRepository: storage,
+		baseURL:           strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (r *PublicURLStorageRepository) GetUrl(fileKey string) (string, error) {
+	return objectURL(r.baseURL, fileKey), nil
+}
+
+// CloudFrontStorageRepository returns CloudFront signed URLs with a canned policy
+type CloudFrontStorageRepository struct {
+	repository.StorageRepository
+	baseURL    string
+	keyPairID  string
+	privateKey *rsa.PrivateKey
+	expires    time.Duration
+	now        func() time.Time
+}
+
+// NewCloudFrontStorageRepository takes the PEM encoded private key of the CloudFront key pair.
+// Escaped newlines ("\n") are accepted so the key can be passed in a single line environment variable
+func NewCloudFrontStorageRepository(storage repository.StorageRepository, baseURL, keyPairID, privateKeyPEM string, expires time.Duration) *CloudFrontStorageRepository {
+	privateKey, err := parseRSAPrivateKey(strings.ReplaceAll(privateKeyPEM, `\n`, "\n"))
+	if err != nil {
+		log.Fatalf("Failed to load CloudFront private key: %v", err)
+	}
+	return &CloudFrontStorageRepository{
+		StorageRepository: storage,
+		baseURL:           strings.TrimRight(baseURL, "/"),
+		keyPairID:         keyPairID,
+		privateKey:        privateKey,
+		expires:           expires,
+		now:               time.Now,
+	}
+}
+
+func (r *CloudFrontStorageRepository) GetUrl(fileKey string) (string, error) {
+	resource := objectURL(r.baseURL, fileKey)
+	expiresAt := r.now().Add(r.expires).Unix()
+
+	policy, err := json.Marshal(map[string]interface{}{
+		"Statement": []interface{}{
+			map[string]interface{}{
+				"Resource": resource,
+				"Condition": map[string]interface{}{
+					"DateLessThan": map[string]int64{"AWS:EpochTime": expiresAt},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha1.Sum(policy)
+	signature, err := rsa.SignPKCS1v15(nil, r.privateKey, crypto.SHA1, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign CloudFront URL: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("Expires", fmt.Sprint(expiresAt))
+	query.Set("Signature", cloudFrontEncoding.Replace(base64.StdEncoding.EncodeToString(signature)))
+	query.Set("Key-Pair-Id", r.keyPairID)
+	return resource + "?" + query.Encode(), nil
+}
+
+// cloudFrontEncoding replaces the base64 characters that are invalid in a query string, as CloudFront expects
+var cloudFrontEncoding = strings.NewReplacer("+", "-", "=", "_", "/", "~")
+
+func parseRSAPrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,147 @@
+package infra
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/lib/pq"
+)
+
+const (
+	// subscriberBufferSize is how many events a slow subscriber may lag behind before events are dropped
+	subscriberBufferSize = 16
+	// streamNotifyChannel is the Postgres channel that PostgresEventHub relays events through
+	streamNotifyChannel = "animalia_stream"
+	// maxNotifyPayload is the limit of a NOTIFY payload in the default Postgres configuration
+	maxNotifyPayload = 8000
+)
+
+// MemoryEventHub fans out events to the subscribers in this process
+type MemoryEventHub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan models.StreamEvent]struct{}
+}
+
+func NewMemoryEventHub() *MemoryEventHub {
+	return &MemoryEventHub{
+		subscribers: map[string]map[chan models.StreamEvent]struct{}{},
+	}
+}
+
+func (h *MemoryEventHub) Publish(channel string, event models.StreamEvent) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for subscriber := range h.subscribers[channel] {
+		// 受信が追いつかない購読者のためにほかの購読者を待たせない
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+func (h *MemoryEventHub) Subscribe(channel string) (<-chan models.StreamEvent, func()) {
+	subscriber := make(chan models.StreamEvent, subscriberBufferSize)
+	h.mu.Lock()
+	if h.subscribers[channel] == nil {
+		h.subscribers[channel] = map[chan models.StreamEvent]struct{}{}
+	}
+	h.subscribers[channel][subscriber] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			delete(h.subscribers[channel], subscriber)
+			if len(h.subscribers[channel]) == 0 {
+				delete(h.subscribers, channel)
+			}
+			close(subscriber)
+		})
+	}
+	return subscriber, unsubscribe
+}
+
+// PostgresEventHub relays events through Postgres LISTEN/NOTIFY, so that every API instance
+// delivers them to its own subscribers
+type PostgresEventHub struct {
+	db       *sql.DB
+	listener *pq.Listener
+	local    *MemoryEventHub
+}
+
+type notifyPayload struct {
+	Channel string             `json:"channel"`
+	Event   models.StreamEvent `json:"event"`
+}
+
+func NewPostgresEventHub(databaseURL string) *PostgresEventHub {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		log.Fatalf("Failed to open event hub connection: %v", err)
+	}
+	listener := pq.NewListener(databaseURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Event hub listener: %v", err)
+		}
+	})
+	if err := listener.Listen(streamNotifyChannel); err != nil {
+		log.Fatalf("Failed to listen for stream events: %v", err)
+	}
+
+	hub := &PostgresEventHub{
+		db:       db,
+		listener: listener,
+		local:    NewMemoryEventHub(),
+	}
+	go hub.relay()
+	return hub
+}
+
+func (h *PostgresEventHub) Publish(channel string, event models.StreamEvent) error {
+	payload, err := json.Marshal(notifyPayload{Channel: channel, Event: event})
+	if err != nil {
+		return err
+	}
+	if len(payload) >= maxNotifyPayload {
+		return fmt.Errorf("stream event is too large: %d bytes", len(payload))
+	}
+	if _, err := h.db.Exec("SELECT pg_notify($1, $2)", streamNotifyChannel, string(payload)); err != nil {
+		return fmt.Errorf("failed to publish stream event: %w", err)
+	}
+	return nil
+}
+
+func (h *PostgresEventHub) Subscribe(channel string) (<-chan models.StreamEvent, func()) {
+	return h.local.Subscribe(channel)
+}
+
+// relay hands the notifications of every instance, including this one, to the local subscribers
+func (h *PostgresEventHub) relay() {
+	for {
+		select {
+		case notification := <-h.listener.Notify:
+			// 再接続の直後は nil が届く。切断中のイベントは失われる
+			if notification == nil {
+				continue
+			}
+			var payload notifyPayload
+			if err := json.Unmarshal([]byte(notification.Extra), &payload); err != nil {
+				log.Printf("Failed to decode stream event: %v", err)
+				continue
+			}
+			h.local.Publish(payload.Channel, payload.Event)
+		case <-time.After(90 * time.Second):
+			// 通知のない間も接続が生きているか確かめる
+			go h.listener.Ping()
+		}
+	}
+}
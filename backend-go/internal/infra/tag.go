@@ -0,0 +1,104 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type TagRepository struct {
+	db *ent.Client
+}
+
+func NewTagRepository(db *ent.Client) *TagRepository {
+	return &TagRepository{
+		db: db,
+	}
+}
+
+func (r *TagRepository) Trending(since time.Time, halfLife time.Duration, limit int) ([]models.TrendingTag, error) {
+	var rows []struct {
+		Name      string  `json:"name"`
+		PostCount int     `json:"post_count"`
+		Score     float64 `json:"score"`
+	}
+	err := r.db.Tag.Query().
+		Modify(func(s *sql.Selector) {
+			tagPosts := sql.Table(tag.PostsTable)
+			posts := sql.Table(post.Table)
+			s.Join(tagPosts).On(s.C(tag.FieldID), tagPosts.C(tag.PostsPrimaryKey[0])).
+				Join(posts).On(tagPosts.C(tag.PostsPrimaryKey[1]), posts.C(post.FieldID)).
+				Where(sql.And(
+					sql.IsNull(posts.C(post.FieldDeletedAt)),
+					sql.GTE(posts.C(post.FieldCreatedAt), since),
+				)).
+				GroupBy(s.C(tag.FieldID), s.C(tag.FieldName)).
+				Select(sql.As(s.C(tag.FieldName), "name")).
+				AppendSelectExprAs(sql.Expr("COUNT(*)"), "post_count").
+				// 投稿からの経過時間が半減期を過ぎるごとに重みを半分にする
+				AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("SUM(POWER(0.5, EXTRACT(EPOCH FROM NOW() - ").
+						WriteString(posts.C(post.FieldCreatedAt)).
+						WriteString(fmt.Sprintf(") / %g))::float8", halfLife.Seconds()))
+				}), "score").
+				OrderExpr(sql.DescExpr(sql.Expr("score"))).
+				OrderBy(s.C(tag.FieldName)).
+				Limit(limit)
+		}).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]models.TrendingTag, len(rows))
+	for i, row := range rows {
+		tags[i] = models.TrendingTag{Name: row.Name, PostCount: row.PostCount, Score: row.Score}
+	}
+	return tags, nil
+}
+
+// upsertTags creates the tags that do not exist yet and returns the IDs of all of them
+func upsertTags(ctx context.Context, tx *ent.Tx, names []string) ([]uuid.UUID, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	creates := make([]*ent.TagCreate, len(names))
+	for i, name := range names {
+		creates[i] = tx.Tag.Create().SetName(name)
+	}
+	if err := tx.Tag.CreateBulk(creates...).
+		OnConflictColumns(tag.FieldName).
+		DoNothing().
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return tx.Tag.Query().Where(tag.NameIn(names...)).IDs(ctx)
+}
+
+// ReplacePostTags sets the tags of the post to the given names, creating the tags that do not exist yet.
+// cmd/tagbackfill uses it to tag the posts written before hashtags were parsed.
+func ReplacePostTags(ctx context.Context, client *ent.Client, postID uuid.UUID, names []string) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	tagIDs, err := upsertTags(ctx, tx, names)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = tx.Post.UpdateOneID(postID).
+		ClearTags().
+		AddTagIDs(tagIDs...).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,74 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+)
+
+// taskScoringTimeout bounds a scoring request so that post creation does not hang on the service.
+// It allows for the service embedding the image of a new post, whose feature the batch extractor has not computed yet,
+// and stays well below the 29 second timeout of the API function, so that a slow service only leaves the task open.
+const taskScoringTimeout = 15 * time.Second
+
+// TaskScoringRepository calls the task scoring service (algorithm/task_scoring_system).
+// The service downloads and embeds the image itself when posts.image_feature is still NULL.
+type TaskScoringRepository struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewTaskScoringRepository(baseURL string) *TaskScoringRepository {
+	return &TaskScoringRepository{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: taskScoringTimeout},
+	}
+}
+
+func (r *TaskScoringRepository) Score(taskType enum.TaskType, imageKey string) (float64, error) {
+	body, err := json.Marshal(map[string]string{
+		"task_type": string(taskType),
+		"image_key": imageKey,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := r.httpClient.Post(r.baseURL+"/task/score", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to call task scoring service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("task scoring service returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Score float64 `json:"score"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode task score: %w", err)
+	}
+	return result.Score, nil
+}
+
+// FakeTaskScoringRepository returns a fixed score, for local development without the scoring service
+type FakeTaskScoringRepository struct {
+	score float64
+}
+
+func NewFakeTaskScoringRepository(score float64) *FakeTaskScoringRepository {
+	return &FakeTaskScoringRepository{
+		score: score,
+	}
+}
+
+func (r *FakeTaskScoringRepository) Score(taskType enum.TaskType, imageKey string) (float64, error) {
+	return r.score, nil
+}
@@ -0,0 +1,67 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/tasktype"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+type TaskTypeRepository struct {
+	db *ent.Client
+}
+
+func NewTaskTypeRepository(db *ent.Client) *TaskTypeRepository {
+	return &TaskTypeRepository{
+		db: db,
+	}
+}
+
+func (r *TaskTypeRepository) List(includeInactive bool) ([]*ent.TaskType, error) {
+	query := r.db.TaskType.Query()
+	if !includeInactive {
+		query = query.Where(tasktype.Active(true))
+	}
+	// text_feature は Python 側でのみ使用するため読み込まない
+	return query.
+		Order(tasktype.ByID()).
+		Select(
+			tasktype.FieldType,
+			tasktype.FieldTitle,
+			tasktype.FieldPrompt,
+			tasktype.FieldPetTypes,
+			tasktype.FieldWeight,
+			tasktype.FieldActive,
+		).
+		All(context.Background())
+}
+
+func (r *TaskTypeRepository) Create(input models.TaskTypeInput) (*ent.TaskType, error) {
+	return r.db.TaskType.Create().
+		SetType(input.Type).
+		SetTitle(input.Title).
+		SetPrompt(input.Prompt).
+		SetPetTypes(input.PetTypes).
+		SetWeight(input.Weight).
+		SetActive(input.Active).
+		Save(context.Background())
+}
+
+func (r *TaskTypeRepository) Update(id int, input models.TaskTypeInput) (*ent.TaskType, error) {
+	return r.db.TaskType.UpdateOneID(id).
+		SetType(input.Type).
+		SetTitle(input.Title).
+		SetPrompt(input.Prompt).
+		SetPetTypes(input.PetTypes).
+		SetWeight(input.Weight).
+		SetActive(input.Active).
+		Save(context.Background())
+}
+
+// Deactivate removes the task from future draws but keeps it for past daily tasks
+func (r *TaskTypeRepository) Deactivate(id int) error {
+	return r.db.TaskType.UpdateOneID(id).
+		SetActive(false).
+		Exec(context.Background())
+}
@@ -0,0 +1,15 @@
+package infra
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+)
+
+// rollback rolls back the transaction and returns the error that caused it
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
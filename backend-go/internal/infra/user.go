@@ -2,9 +2,12 @@ package infra
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -32,21 +35,28 @@ func (r *UserRepository) Create(name, email string) (*ent.User, error) {
 		return nil, fmt.Errorf("このメールアドレスは既に登録されています")
 	}
 
-	userCount, err := r.db.User.Query().Count(context.Background())
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	user, err := r.db.User.Create().
+	index, err := allocateIndex(ctx, tx, UserIndexCounter)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	user, err := tx.User.Create().
 		SetName(name).
 		SetEmail(email).
-		SetIndex(userCount).
-		Save(context.Background())
+		SetIndex(index).
+		Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user in database: %w", err)
+		return nil, rollback(tx, fmt.Errorf("failed to create user in database: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 
-	return user, nil
+	return user.Unwrap(), nil
 }
 
 func (r *UserRepository) ExistsEmail(email string) (bool, error) {
@@ -92,23 +102,64 @@ func (r *UserRepository) Update(id string, name string, description string, newI
 	return err
 }
 
-func (r *UserRepository) Follow(fromID string, toID string) error {
-	fromUUID, err := uuid.Parse(fromID)
+func (r *UserRepository) UpdatePushOptOuts(id string, kinds []string) error {
+	userUUID, err := uuid.Parse(id)
 	if err != nil {
 		return err
 	}
+	return r.db.User.UpdateOneID(userUUID).
+		SetPushOptOuts(kinds).
+		Exec(context.Background())
+}
+
+// Follow creates the follow relation and reports whether it was inserted, or false when it already existed
+func (r *UserRepository) Follow(fromID string, toID string) (bool, error) {
+	fromUUID, err := uuid.Parse(fromID)
+	if err != nil {
+		return false, err
+	}
 
 	toUUID, err := uuid.Parse(toID)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	_, err = r.db.FollowRelation.Create().
+	// 既にフォローしている場合は ON CONFLICT DO NOTHING で行が返らない
+	err = r.db.FollowRelation.Create().
 		SetFromID(fromUUID).
 		SetToID(toUUID).
-		Save(context.Background())
+		OnConflictColumns(followrelation.FromColumn, followrelation.ToColumn).
+		DoNothing().
+		Exec(context.Background())
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create follow relation in database: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *UserRepository) Unfollow(fromID string, toID string) error {
+	fromUUID, err := uuid.Parse(fromID)
+	if err != nil {
+		return err
+	}
+
+	toUUID, err := uuid.Parse(toID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.FollowRelation.Delete().
+		Where(
+			followrelation.HasFromWith(user.ID(fromUUID)),
+			followrelation.HasToWith(user.ID(toUUID)),
+		).
+		Exec(context.Background())
 	if err != nil {
-		return fmt.Errorf("failed to create follow relation in database: %w", err)
+		return fmt.Errorf("failed to delete follow relation in database: %w", err)
 	}
 
 	return nil
@@ -4,8 +4,10 @@ import (
 	"context"
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/aki-13627/animalia/backend-go/internal/handler"
 	"github.com/aki-13627/animalia/backend-go/internal/infra"
@@ -13,7 +15,15 @@ import (
 	_ "github.com/lib/pq" // PostgreSQLドライバー
 )
 
-var client *ent.Client
+var (
+	client            *ent.Client
+	authRepository    repository.AuthRepository
+	storageRepository repository.StorageRepository
+	// localStorageRepository is set when STORAGE_BACKEND is "local", and serves its files through FileHandler
+	localStorageRepository *infra.LocalStorageRepository
+	pushDeliveryWorker     *usecase.PushDeliveryWorker
+	eventHub               repository.EventHub
+)
 
 func InjectDB() *ent.Client {
 	if client == nil {
@@ -24,15 +34,26 @@ func InjectDB() *ent.Client {
 		}
 
 		// Run the auto migration tool
-		if err := client.Schema.Create(context.Background()); err != nil {
+		if err := infra.MigrateSchema(context.Background(), client); err != nil {
 			log.Fatalf("failed creating schema resources: %v", err)
 		}
 	}
 	return client
 }
 
-func InjectCognitoRepository() repository.AuthRepository {
-	authRepository := infra.NewCognitoRepository()
+// InjectAuthRepository selects the auth provider with AUTH_PROVIDER: "cognito" (default) or "local"
+func InjectAuthRepository() repository.AuthRepository {
+	// JWK の取得は起動時に一度だけ行い、ミドルウェアとハンドラで共有する
+	if authRepository == nil {
+		switch provider := os.Getenv("AUTH_PROVIDER"); provider {
+		case "", "cognito":
+			authRepository = infra.NewCognitoRepository()
+		case "local":
+			authRepository = infra.NewLocalAuthRepository(InjectDB(), envOrDefault("LOCAL_AUTH_KEY_FILE", "./local_auth_key.pem"))
+		default:
+			log.Fatalf("unknown AUTH_PROVIDER: %s", provider)
+		}
+	}
 	return authRepository
 }
 
@@ -56,11 +77,115 @@ func InjectPetRepository() repository.PetRepository {
 	return petRepository
 }
 
+// InjectStorageRepository shares one repository so that the URL cache is shared by all usecases.
+// STORAGE_BACKEND selects where images are stored: "s3" (default, also MinIO with AWS_S3_ENDPOINT) or "local".
+// STORAGE_URL_MODE selects how image URLs are built: "presign" (default), "public" or "cloudfront"
 func InjectStorageRepository() repository.StorageRepository {
-	storageRepository := infra.NewS3Repository(os.Getenv("AWS_S3_BUCKET_NAME"))
+	if storageRepository != nil {
+		return storageRepository
+	}
+
+	var backend repository.StorageRepository
+	switch name := os.Getenv("STORAGE_BACKEND"); name {
+	case "", "s3":
+		backend = infra.NewS3Repository(
+			os.Getenv("AWS_S3_BUCKET_NAME"),
+			os.Getenv("AWS_S3_ENDPOINT"),
+			os.Getenv("AWS_S3_FORCE_PATH_STYLE") == "true",
+		)
+	case "local":
+		localStorageRepository = infra.NewLocalStorageRepository(
+			envOrDefault("STORAGE_LOCAL_DIR", "./storage"),
+			envOrDefault("STORAGE_LOCAL_BASE_URL", "http://localhost:"+envOrDefault("PORT", "3000")),
+			os.Getenv("STORAGE_SIGNING_SECRET"),
+		)
+		backend = localStorageRepository
+	default:
+		log.Fatalf("unknown STORAGE_BACKEND: %s", name)
+	}
+
+	switch mode := os.Getenv("STORAGE_URL_MODE"); mode {
+	case "", "presign":
+		storageRepository = infra.NewCachedStorageRepository(backend, infra.PresignedURLExpiry)
+	case "public":
+		storageRepository = infra.NewPublicURLStorageRepository(backend, os.Getenv("STORAGE_PUBLIC_BASE_URL"))
+	case "cloudfront":
+		cloudFrontRepository := infra.NewCloudFrontStorageRepository(
+			backend,
+			os.Getenv("STORAGE_PUBLIC_BASE_URL"),
+			os.Getenv("CLOUDFRONT_KEY_PAIR_ID"),
+			os.Getenv("CLOUDFRONT_PRIVATE_KEY"),
+			infra.PresignedURLExpiry,
+		)
+		storageRepository = infra.NewCachedStorageRepository(cloudFrontRepository, infra.PresignedURLExpiry)
+	default:
+		log.Fatalf("unknown STORAGE_URL_MODE: %s", mode)
+	}
 	return storageRepository
 }
 
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func InjectDailyTaskRepository() repository.DailyTaskRepository {
+	dailyTaskRepository := infra.NewDailyTaskRepository(InjectDB())
+	return dailyTaskRepository
+}
+
+func InjectTaskTypeRepository() repository.TaskTypeRepository {
+	taskTypeRepository := infra.NewTaskTypeRepository(InjectDB())
+	return taskTypeRepository
+}
+
+// InjectTaskScoringRepository calls the service at TASK_SCORING_URL.
+// TASK_SCORING=fake opts into a stand-in that completes every task, for local development without the service.
+func InjectTaskScoringRepository() repository.TaskScoringRepository {
+	if os.Getenv("TASK_SCORING") == "fake" {
+		return infra.NewFakeTaskScoringRepository(100)
+	}
+	baseURL := os.Getenv("TASK_SCORING_URL")
+	if baseURL == "" {
+		log.Fatal("TASK_SCORING_URL environment variable is not set (set TASK_SCORING=fake to complete every task without the service)")
+	}
+	return infra.NewTaskScoringRepository(baseURL)
+}
+
+// InjectEmbeddingRepository calls the service at EMBEDDING_URL. EMBEDDING=stub opts into the word-hashing stub
+// for local development. Without either, search matches captions only.
+func InjectEmbeddingRepository() repository.EmbeddingRepository {
+	if os.Getenv("EMBEDDING") == "stub" {
+		return infra.NewStubEmbeddingRepository()
+	}
+	baseURL := os.Getenv("EMBEDDING_URL")
+	if baseURL == "" {
+		log.Println("Warning: EMBEDDING_URL is not set, search matches captions only")
+		return infra.NewUnconfiguredEmbeddingRepository()
+	}
+	return infra.NewEmbeddingRepository(baseURL)
+}
+
+// InjectRecommendationRepository returns nil when RECOMMEND_URL is not set, so that the latest posts are served instead
+func InjectRecommendationRepository() repository.RecommendationRepository {
+	baseURL := os.Getenv("RECOMMEND_URL")
+	if baseURL == "" {
+		return nil
+	}
+	return infra.NewRecommendationRepository(baseURL)
+}
+
+// InjectTaskScoreThreshold reads TASK_SCORE_THRESHOLD, falling back to the default threshold
+func InjectTaskScoreThreshold() float64 {
+	threshold, err := strconv.ParseFloat(os.Getenv("TASK_SCORE_THRESHOLD"), 64)
+	if err != nil {
+		return models.DefaultTaskScoreThreshold
+	}
+	return threshold
+}
+
 func InjectLikeRepository() repository.LikeRepository {
 	likeRepository := infra.NewLikeRepository(InjectDB())
 	return likeRepository
@@ -71,40 +196,159 @@ func InjectCommentRepository() repository.CommentRepository {
 	return commentRepository
 }
 
+func InjectTagRepository() repository.TagRepository {
+	tagRepository := infra.NewTagRepository(InjectDB())
+	return tagRepository
+}
+
+func InjectNotificationRepository() repository.NotificationRepository {
+	notificationRepository := infra.NewNotificationRepository(InjectDB())
+	return notificationRepository
+}
+
+func InjectImageReferenceRepository() repository.ImageReferenceRepository {
+	imageReferenceRepository := infra.NewImageReferenceRepository(InjectDB())
+	return imageReferenceRepository
+}
+
+func InjectPushTokenRepository() repository.PushTokenRepository {
+	pushTokenRepository := infra.NewPushTokenRepository(InjectDB())
+	return pushTokenRepository
+}
+
+// InjectPushSender sends through Expo when PUSH_SENDER is "expo", and otherwise only logs the messages
+func InjectPushSender() repository.PushSender {
+	if os.Getenv("PUSH_SENDER") == "expo" {
+		return infra.NewExpoPushSender(envOrDefault("EXPO_PUSH_URL", infra.ExpoPushURL), os.Getenv("EXPO_ACCESS_TOKEN"))
+	}
+	return infra.NewFakePushSender()
+}
+
+// InjectPushDeliveryWorker creates the single worker shared by all notifiers. On Lambda, where the process is
+// frozen as soon as the response is sent, it is not started and pushes are delivered within the request.
+func InjectPushDeliveryWorker() *usecase.PushDeliveryWorker {
+	if pushDeliveryWorker == nil {
+		pushDeliveryWorker = usecase.NewPushDeliveryWorker(InjectUserRepository(), InjectPushTokenRepository(), InjectPushSender())
+		if os.Getenv("AWS_LAMBDA_FUNCTION_NAME") == "" {
+			pushDeliveryWorker.Start()
+		}
+	}
+	return pushDeliveryWorker
+}
+
+// InjectEventHub shares one hub between publishers and streams. EVENT_HUB selects the implementation:
+// "memory" (default) for a single instance, or "postgres" to fan out across instances with LISTEN/NOTIFY.
+func InjectEventHub() repository.EventHub {
+	if eventHub == nil {
+		switch hub := os.Getenv("EVENT_HUB"); hub {
+		case "", "memory":
+			eventHub = infra.NewMemoryEventHub()
+		case "postgres":
+			eventHub = infra.NewPostgresEventHub(os.Getenv("DATABASE_URL"))
+		default:
+			log.Fatalf("unknown EVENT_HUB: %s", hub)
+		}
+	}
+	return eventHub
+}
+
+func InjectStreamer() *usecase.Streamer {
+	return usecase.NewStreamer(InjectEventHub())
+}
+
+func InjectNotifier() *usecase.Notifier {
+	return usecase.NewNotifier(InjectNotificationRepository(), InjectPostRepository(), InjectPushDeliveryWorker(), InjectStreamer())
+}
+
+func InjectAuthorizer() *usecase.Authorizer {
+	return usecase.NewAuthorizer(InjectPetRepository(), InjectPostRepository(), InjectCommentRepository())
+}
+
 func InjectAuthUsecase() usecase.AuthUsecase {
-	authUsecase := usecase.NewAuthUsecase(InjectCognitoRepository(), InjectUserRepository())
+	authUsecase := usecase.NewAuthUsecase(InjectAuthRepository(), InjectUserRepository())
 	return *authUsecase
 }
 
 func InjectPostUsecase() usecase.PostUsecase {
-	postUsecase := usecase.NewPostUsecase(InjectPostRepository())
+	postUsecase := usecase.NewPostUsecase(InjectPostRepository(), InjectDailyTaskRepository(), InjectStorageRepository(), InjectLikeRepository(), InjectCommentRepository(), InjectTaskScoringRepository(), InjectTaskScoreThreshold(), InjectRecommendationRepository(), InjectAuthorizer())
 	return *postUsecase
 }
 
+func InjectPostReaper() usecase.PostReaper {
+	postReaper := usecase.NewPostReaper(InjectPostRepository(), InjectStorageRepository())
+	return *postReaper
+}
+
 func InjectPetUsecase() usecase.PetUsecase {
-	petUsecase := usecase.NewPetUsecase(InjectPetRepository())
+	petUsecase := usecase.NewPetUsecase(InjectPetRepository(), InjectAuthorizer())
 	return *petUsecase
 }
 
 func InjectStorageUsecase() usecase.StorageUsecase {
-	storageUsecase := usecase.NewStorageUsecase(InjectStorageRepository())
+	storageUsecase := usecase.NewStorageUsecase(InjectStorageRepository(), InjectImageReferenceRepository())
 	return *storageUsecase
 }
 
 func InjectUserUsecase() usecase.UserUsecase {
-	userUsecase := usecase.NewUserUsecase(InjectUserRepository(), InjectStorageRepository(), InjectPostRepository(), InjectPetRepository(), InjectFollowRelationRepository())
+	userUsecase := usecase.NewUserUsecase(InjectUserRepository(), InjectStorageRepository(), InjectPostRepository(), InjectPetRepository(), InjectFollowRelationRepository(), InjectLikeRepository(), InjectCommentRepository(), InjectNotifier())
 	return *userUsecase
 }
 
 func InjectLikeUsecase() usecase.LikeUsecase {
-	likeUsecase := usecase.NewLikeUsecase(InjectLikeRepository())
+	likeUsecase := usecase.NewLikeUsecase(InjectLikeRepository(), InjectNotifier(), InjectStreamer())
 	return *likeUsecase
 }
 
+func InjectDailyTaskUsecase() usecase.DailyTaskUsecase {
+	dailyTaskUsecase := usecase.NewDailyTaskUsecase(InjectDailyTaskRepository(), InjectTaskTypeRepository())
+	return *dailyTaskUsecase
+}
+
+func InjectTaskTypeUsecase() usecase.TaskTypeUsecase {
+	taskTypeUsecase := usecase.NewTaskTypeUsecase(InjectTaskTypeRepository())
+	return *taskTypeUsecase
+}
+
+func InjectSearchUsecase() usecase.SearchUsecase {
+	searchUsecase := usecase.NewSearchUsecase(InjectPostRepository(), InjectEmbeddingRepository(), InjectStorageRepository(), InjectLikeRepository(), InjectCommentRepository())
+	return *searchUsecase
+}
+
+// InjectFileUsecase returns false unless the local storage backend is in use
+func InjectFileUsecase() (usecase.FileUsecase, bool) {
+	InjectStorageRepository()
+	if localStorageRepository == nil {
+		return usecase.FileUsecase{}, false
+	}
+	fileUsecase := usecase.NewFileUsecase(localStorageRepository, localStorageRepository)
+	return *fileUsecase, true
+}
+
 func InjectCommentUsecase() usecase.CommentUsecase {
-	commentUsecase := usecase.NewCommentUsecase(InjectCommentRepository(), InjectStorageRepository())
+	commentUsecase := usecase.NewCommentUsecase(InjectCommentRepository(), InjectPostRepository(), InjectStorageRepository(), InjectAuthorizer(), InjectNotifier(), InjectStreamer())
 	return *commentUsecase
 }
+
+func InjectNotificationUsecase() usecase.NotificationUsecase {
+	notificationUsecase := usecase.NewNotificationUsecase(InjectNotificationRepository(), InjectStorageRepository())
+	return *notificationUsecase
+}
+
+func InjectPushUsecase() usecase.PushUsecase {
+	pushUsecase := usecase.NewPushUsecase(InjectPushTokenRepository(), InjectUserRepository())
+	return *pushUsecase
+}
+
+func InjectTagUsecase() usecase.TagUsecase {
+	tagUsecase := usecase.NewTagUsecase(InjectTagRepository(), InjectPostRepository(), InjectStorageRepository(), InjectLikeRepository(), InjectCommentRepository())
+	return *tagUsecase
+}
+
+func InjectStreamUsecase() usecase.StreamUsecase {
+	streamUsecase := usecase.NewStreamUsecase(InjectEventHub(), InjectPostRepository())
+	return *streamUsecase
+}
+
 func InjectAuthHandler() handler.AuthHandler {
 	authHandler := handler.NewAuthHandler(InjectAuthUsecase(), InjectUserUsecase(), InjectStorageUsecase())
 	return *authHandler
@@ -134,3 +378,53 @@ func InjectCommentHandler() handler.CommentHandler {
 	commentHandler := handler.NewCommentHandler(InjectCommentUsecase())
 	return *commentHandler
 }
+
+func InjectDailyTaskHandler() handler.DailyTaskHandler {
+	dailyTaskHandler := handler.NewDailyTaskHandler(InjectDailyTaskUsecase())
+	return *dailyTaskHandler
+}
+
+func InjectTaskTypeHandler() handler.TaskTypeHandler {
+	taskTypeHandler := handler.NewTaskTypeHandler(InjectTaskTypeUsecase())
+	return *taskTypeHandler
+}
+
+func InjectSearchHandler() handler.SearchHandler {
+	searchHandler := handler.NewSearchHandler(InjectSearchUsecase())
+	return *searchHandler
+}
+
+func InjectUploadHandler() handler.UploadHandler {
+	uploadHandler := handler.NewUploadHandler(InjectStorageUsecase())
+	return *uploadHandler
+}
+
+func InjectNotificationHandler() handler.NotificationHandler {
+	notificationHandler := handler.NewNotificationHandler(InjectNotificationUsecase())
+	return *notificationHandler
+}
+
+func InjectPushHandler() handler.PushHandler {
+	pushHandler := handler.NewPushHandler(InjectPushUsecase())
+	return *pushHandler
+}
+
+func InjectTagHandler() handler.TagHandler {
+	tagHandler := handler.NewTagHandler(InjectTagUsecase())
+	return *tagHandler
+}
+
+func InjectStreamHandler() handler.StreamHandler {
+	streamHandler := handler.NewStreamHandler(InjectStreamUsecase())
+	return *streamHandler
+}
+
+// InjectFileHandler returns false unless the local storage backend is in use
+func InjectFileHandler() (handler.FileHandler, bool) {
+	fileUsecase, ok := InjectFileUsecase()
+	if !ok {
+		return handler.FileHandler{}, false
+	}
+	fileHandler := handler.NewFileHandler(fileUsecase)
+	return *fileHandler, true
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupAdminRoutes sets up the routes for managing the task catalog
+func SetupAdminRoutes(app *echo.Echo) {
+	taskTypeHandler := injector.InjectTaskTypeHandler()
+	adminGroup := app.Group("/admin", AuthMiddleware(), AdminMiddleware())
+
+	// List all task types including inactive ones
+	adminGroup.GET("/task-types", taskTypeHandler.List)
+
+	// Add a task type to the catalog
+	adminGroup.POST("/task-types", taskTypeHandler.Create)
+
+	// Update a task type
+	adminGroup.PUT("/task-types/:id", taskTypeHandler.Update)
+
+	// Deactivate a task type
+	adminGroup.DELETE("/task-types/:id", taskTypeHandler.Deactivate)
+}
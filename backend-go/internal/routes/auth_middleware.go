@@ -0,0 +1,83 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/handler"
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// AuthMiddleware verifies the bearer token and stores the signed-in user in the request context
+func AuthMiddleware() echo.MiddlewareFunc {
+	return authMiddleware(false)
+}
+
+// OptionalAuthMiddleware lets anonymous requests through but still resolves the user when a token is sent
+func OptionalAuthMiddleware() echo.MiddlewareFunc {
+	return authMiddleware(true)
+}
+
+// AdminMiddleware rejects users without the admin flag. It must run after AuthMiddleware.
+func AdminMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := c.Get(handler.UserContextKey).(*ent.User)
+			if !ok || !user.IsAdmin {
+				log.Error("Failed to authorize: admin privileges are required")
+				return c.JSON(http.StatusForbidden, map[string]interface{}{
+					"error": "管理者権限が必要です",
+				})
+			}
+			return next(c)
+		}
+	}
+}
+
+func authMiddleware(optional bool) echo.MiddlewareFunc {
+	authUsecase := injector.InjectAuthUsecase()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" && optional {
+				return next(c)
+			}
+			if !strings.HasPrefix(authHeader, "Bearer ") || len(authHeader) < 8 {
+				log.Error("Failed to authenticate: token is empty")
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"error": "アクセストークンが必要です",
+				})
+			}
+			token := strings.TrimPrefix(authHeader, "Bearer ")
+
+			email, err := authUsecase.VerifyToken(token)
+			if err != nil {
+				log.Errorf("Failed to authenticate: %v", err)
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"error": "無効なアクセストークンです",
+				})
+			}
+
+			user, err := authUsecase.FindByEmail(email)
+			if ent.IsNotFound(err) {
+				log.Errorf("Failed to authenticate: user not found: %v", err)
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"error": "ユーザーが見つかりません",
+				})
+			}
+			if err != nil {
+				log.Errorf("Failed to authenticate: failed to get user: %v", err)
+				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+					"error": "ユーザー情報の取得に失敗しました",
+				})
+			}
+
+			c.Set(handler.UserContextKey, user)
+			return next(c)
+		}
+	}
+}
@@ -30,4 +30,7 @@ func SetupAuthRoutes(app *echo.Echo) {
 
 	// Get session
 	authGroup.GET("/session", authHandler.GetSession)
+
+	// Public keys of the tokens
+	app.GET("/.well-known/jwks.json", authHandler.GetJWKS)
 }
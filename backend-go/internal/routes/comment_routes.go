@@ -8,13 +8,20 @@ import (
 func SetupCommentRoutes(app *echo.Echo) {
 	commentHandler := injector.InjectCommentHandler()
 	commentGroup := app.Group("/comments")
+	authMiddleware := AuthMiddleware()
 
-	// Create a new comment
-	commentGroup.POST("/new", commentHandler.Create)
+	// Get one page of the top-level comments of a post
+	commentGroup.GET("/post/:postId", commentHandler.GetByPostId)
 
-	// Delete a comment
-	commentGroup.DELETE("/delete", commentHandler.Delete)
+	// Create a new comment, or a reply with parentId
+	commentGroup.POST("/post/:postId", commentHandler.Create, authMiddleware)
+
+	// Get one page of the replies to a comment
+	commentGroup.GET("/:commentId/replies", commentHandler.GetReplies)
 
-	// Get comments for a post
-	commentGroup.GET("/post", commentHandler.GetByPostId)
+	// Edit a comment
+	commentGroup.PUT("/:commentId", commentHandler.Update, authMiddleware)
+
+	// Delete a comment
+	commentGroup.DELETE("/:commentId", commentHandler.Delete, authMiddleware)
 }
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupFileRoutes serves the local storage when STORAGE_BACKEND is "local". The URLs carry their own signature, so no auth is needed.
+func SetupFileRoutes(app *echo.Echo) {
+	fileHandler, ok := injector.InjectFileHandler()
+	if !ok {
+		return
+	}
+	fileGroup := app.Group("/files")
+
+	// Download a file through a URL issued by GetUrl
+	fileGroup.GET("/*", fileHandler.Get)
+	// Upload a file to a URL issued by POST /uploads
+	fileGroup.PUT("/*", fileHandler.Put)
+}
@@ -8,13 +8,14 @@ import (
 func SetupLikeRoutes(app *echo.Echo) {
 	likeHandler := injector.InjectLikeHandler()
 	likeGroup := app.Group("/likes")
+	authMiddleware := AuthMiddleware()
 
 	// Create a new like
-	likeGroup.POST("/new", likeHandler.Create)
+	likeGroup.POST("/post/:postId", likeHandler.Create, authMiddleware)
 
 	// Delete a like
-	likeGroup.DELETE("/delete", likeHandler.Delete)
+	likeGroup.DELETE("/post/:postId", likeHandler.Delete, authMiddleware)
 
 	// Count likes for a post
-	likeGroup.GET("/count", likeHandler.Count)
+	likeGroup.GET("/post/:postId/count", likeHandler.Count)
 }
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupNotificationRoutes sets up the notification routes
+func SetupNotificationRoutes(app *echo.Echo) {
+	notificationHandler := injector.InjectNotificationHandler()
+	notificationGroup := app.Group("/notifications", AuthMiddleware())
+
+	// List notifications, most recent first
+	notificationGroup.GET("", notificationHandler.List)
+
+	// Count unread notifications
+	notificationGroup.GET("/unread-count", notificationHandler.UnreadCount)
+
+	// Mark all notifications as read
+	notificationGroup.POST("/read", notificationHandler.MarkAllRead)
+
+	// Mark a notification as read
+	notificationGroup.POST("/:id/read", notificationHandler.MarkRead)
+}
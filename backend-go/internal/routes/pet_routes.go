@@ -9,14 +9,15 @@ import (
 func SetupPetRoutes(app *echo.Echo) {
 	petHandler := injector.InjectPetHandler()
 	petGroup := app.Group("/pets")
+	authMiddleware := AuthMiddleware()
 
 	// Get pets by owner ID
 	petGroup.GET("/owner", petHandler.GetByOwner)
 
 	// Create a new pet
-	petGroup.POST("/new", petHandler.Create)
+	petGroup.POST("/new", petHandler.Create, authMiddleware)
 
-	petGroup.PUT("/update", petHandler.Update)
+	petGroup.PUT("/update", petHandler.Update, authMiddleware)
 
-	petGroup.DELETE("/delete", petHandler.Delete)
+	petGroup.DELETE("/delete", petHandler.Delete, authMiddleware)
 }
@@ -9,11 +9,30 @@ import (
 func SetupPostRoutes(app *echo.Echo) {
 	postHandler := injector.InjectPostHandler()
 	postGroup := app.Group("/posts")
+	authMiddleware := AuthMiddleware()
+	optionalAuthMiddleware := OptionalAuthMiddleware()
 
 	// Get all posts
-	postGroup.GET("/", postHandler.GetAllPosts)
+	postGroup.GET("/", postHandler.GetAllPosts, optionalAuthMiddleware)
+
+	// Get one page of the timeline
+	postGroup.GET("/timeline", postHandler.GetTimeline, optionalAuthMiddleware)
+
+	// Get one page of the recommended timeline
+	postGroup.GET("/recommended", postHandler.GetRecommended, optionalAuthMiddleware)
+
+	// Get a post
+	postGroup.GET("/:id", postHandler.GetById, optionalAuthMiddleware)
+
+	// Get posts with similar images
+	postGroup.GET("/:id/similar", postHandler.GetSimilar, optionalAuthMiddleware)
 
 	// Create a new post
-	postGroup.POST("/", postHandler.CreatePost)
+	postGroup.POST("/", postHandler.CreatePost, authMiddleware)
+
+	// Update a post
+	postGroup.PUT("/:id", postHandler.UpdatePost, authMiddleware)
 
+	// Delete a post
+	postGroup.DELETE("/:id", postHandler.DeletePost, authMiddleware)
 }
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupPushRoutes sets up the routes for push notification devices and preferences
+func SetupPushRoutes(app *echo.Echo) {
+	pushHandler := injector.InjectPushHandler()
+	pushGroup := app.Group("/push", AuthMiddleware())
+
+	// Register the Expo push token of the device
+	pushGroup.POST("/tokens", pushHandler.RegisterToken)
+
+	// Unregister a push token, e.g. on sign out
+	pushGroup.DELETE("/tokens", pushHandler.UnregisterToken)
+
+	// Get which notification kinds are pushed
+	pushGroup.GET("/preferences", pushHandler.GetPreferences)
+
+	// Opt in or out of notification kinds
+	pushGroup.PUT("/preferences", pushHandler.UpdatePreferences)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupSearchRoutes sets up the search routes
+func SetupSearchRoutes(app *echo.Echo) {
+	searchHandler := injector.InjectSearchHandler()
+	searchGroup := app.Group("/search", OptionalAuthMiddleware())
+
+	// Search posts by text against their images and captions
+	searchGroup.GET("/posts", searchHandler.SearchPosts)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupStreamRoutes sets up the Server-Sent Events streams
+func SetupStreamRoutes(app *echo.Echo) {
+	streamHandler := injector.InjectStreamHandler()
+
+	// Stream new, edited and deleted comments and like counts of a post
+	app.GET("/posts/:id/stream", streamHandler.StreamPost, OptionalAuthMiddleware())
+
+	// Stream notifications of the signed-in user
+	app.GET("/notifications/stream", streamHandler.StreamNotifications, AuthMiddleware())
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupTagRoutes sets up the hashtag routes
+func SetupTagRoutes(app *echo.Echo) {
+	tagHandler := injector.InjectTagHandler()
+	tagGroup := app.Group("/tags", OptionalAuthMiddleware())
+
+	// Get tags ranked by recent use
+	tagGroup.GET("/trending", tagHandler.GetTrending)
+
+	// Get one page of the posts with a tag
+	tagGroup.GET("/:name/posts", tagHandler.GetPosts)
+}
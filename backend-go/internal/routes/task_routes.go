@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupTaskRoutes sets up the daily task routes
+func SetupTaskRoutes(app *echo.Echo) {
+	dailyTaskHandler := injector.InjectDailyTaskHandler()
+	taskGroup := app.Group("/tasks", AuthMiddleware())
+
+	// Get today's task and the completion streak
+	taskGroup.GET("/today", dailyTaskHandler.GetToday)
+
+	// Get past tasks
+	taskGroup.GET("/history", dailyTaskHandler.GetHistory)
+}
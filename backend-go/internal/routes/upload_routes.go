@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupUploadRoutes sets up the routes for direct uploads to storage
+func SetupUploadRoutes(app *echo.Echo) {
+	uploadHandler := injector.InjectUploadHandler()
+	uploadGroup := app.Group("/uploads", AuthMiddleware())
+
+	// Issue a presigned URL to upload an image to
+	uploadGroup.POST("", uploadHandler.Create)
+}
@@ -8,16 +8,24 @@ import (
 func SetupUserRoutes(app *echo.Echo) {
 	userHandler := injector.InjectUserHandler()
 	userGroup := app.Group("/users")
+	authMiddleware := AuthMiddleware()
+	optionalAuthMiddleware := OptionalAuthMiddleware()
 
-	userGroup.PUT("/update", userHandler.UpdateUser)
+	userGroup.PUT("/update", userHandler.UpdateUser, authMiddleware)
 
-	userGroup.POST("/follow", userHandler.Follow)
+	userGroup.POST("/follow", userHandler.Follow, authMiddleware)
+
+	userGroup.DELETE("/follow", userHandler.Unfollow, authMiddleware)
+
+	userGroup.GET("/:id", userHandler.GetProfile, optionalAuthMiddleware)
+
+	userGroup.GET("/:id/relationship", userHandler.GetRelationship, authMiddleware)
 
 	userGroup.GET("/follower_count", userHandler.GetFollowerCount)
 
 	userGroup.GET("/follows_count", userHandler.GetFollowsCount)
 
-	userGroup.GET("/follower_users", userHandler.GetFollowerUsers)
+	userGroup.GET("/follower_users", userHandler.GetFollowerUsers, optionalAuthMiddleware)
 
-	userGroup.GET("/follows_users", userHandler.GetFollowsUsers)
+	userGroup.GET("/follows_users", userHandler.GetFollowsUsers, optionalAuthMiddleware)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // SeedData populates the database with sample data
@@ -133,6 +134,12 @@ func SeedData(client *ent.Client) error {
 		return err
 	}
 
+	log.Debug("Creating local accounts...")
+	if err := createLocalAccounts(client, users); err != nil {
+		log.Errorf("Failed to create local accounts: %v", err)
+		return err
+	}
+
 	log.Info("Database seeding completed successfully")
 	return nil
 }
@@ -143,12 +150,21 @@ func ClearDatabase(client *ent.Client) error {
 	ctx := context.Background()
 
 	// 各テーブルのデータを個別に削除
+	if _, err := client.PushToken.Delete().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear push tokens: %v", err)
+	}
+	if _, err := client.Notification.Delete().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear notifications: %v", err)
+	}
 	if _, err := client.Like.Delete().Exec(ctx); err != nil {
 		return fmt.Errorf("failed to clear likes: %v", err)
 	}
 	if _, err := client.Comment.Delete().Exec(ctx); err != nil {
 		return fmt.Errorf("failed to clear comments: %v", err)
 	}
+	if _, err := client.Tag.Delete().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear tags: %v", err)
+	}
 	if _, err := client.Post.Delete().Exec(ctx); err != nil {
 		return fmt.Errorf("failed to clear posts: %v", err)
 	}
@@ -167,11 +183,38 @@ func ClearDatabase(client *ent.Client) error {
 	if _, err := client.User.Delete().Exec(ctx); err != nil {
 		return fmt.Errorf("failed to clear users: %v", err)
 	}
+	if _, err := client.LocalAccount.Delete().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear local accounts: %v", err)
+	}
+	// 採番はシード後の index の最大値から再開する
+	if _, err := client.IndexCounter.Delete().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear index counters: %v", err)
+	}
 
 	log.Info("Database cleared successfully")
 	return nil
 }
 
+// seedPassword is the password of every seeded user when signing in with AUTH_PROVIDER=local
+const seedPassword = "password123"
+
+// createLocalAccounts lets the sample users sign in with the local auth provider
+func createLocalAccounts(client *ent.Client, users []*ent.User) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(seedPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.LocalAccountCreate, 0, len(users))
+	for _, user := range users {
+		builders = append(builders, client.LocalAccount.Create().
+			SetEmail(user.Email).
+			SetPasswordHash(string(hash)).
+			SetEmailVerified(true))
+	}
+	return client.LocalAccount.CreateBulk(builders...).Exec(context.Background())
+}
+
 // createPets creates sample pets for users
 func createPets(client *ent.Client, users []*ent.User) ([]*ent.Pet, error) {
 	log.Info("Creating sample pets...")
@@ -377,11 +420,26 @@ func createTaskTypes(client *ent.Client) error {
 
 	taskTypes := []*ent.TaskTypeCreate{
 		client.TaskType.Create().
-			SetType(enum.TypeEating),
+			SetType(enum.TypeEating).
+			SetTitle("ごはん").
+			SetPrompt(map[string]string{
+				"ja": "ごはんを食べている様子を撮影しよう",
+				"en": "Take a photo of your pet eating",
+			}),
 		client.TaskType.Create().
-			SetType(enum.TypeSleeping),
+			SetType(enum.TypeSleeping).
+			SetTitle("おやすみ").
+			SetPrompt(map[string]string{
+				"ja": "眠っている様子を撮影しよう",
+				"en": "Take a photo of your pet sleeping",
+			}),
 		client.TaskType.Create().
-			SetType(enum.TypePlaying),
+			SetType(enum.TypePlaying).
+			SetTitle("あそび").
+			SetPrompt(map[string]string{
+				"ja": "遊んでいる様子を撮影しよう",
+				"en": "Take a photo of your pet playing",
+			}),
 	}
 
 	_, err := client.TaskType.CreateBulk(taskTypes...).Save(context.Background())
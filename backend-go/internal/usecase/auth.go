@@ -2,8 +2,8 @@ package usecase
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
-	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 )
 
 type AuthUsecase struct {
@@ -26,15 +26,20 @@ func (u *AuthUsecase) CreateUser(name, email, password string) error {
 	return u.authRepository.CreateUser(name, email, password)
 }
 
-func (u *AuthUsecase) SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (u *AuthUsecase) SignIn(email, password string) (*models.TokenPair, error) {
 	return u.authRepository.SignIn(email, password)
 }
 
+// VerifyToken verifies the bearer token and returns the email of the user it belongs to.
+func (u *AuthUsecase) VerifyToken(token string) (string, error) {
+	return u.authRepository.VerifyToken(token)
+}
+
 func (u *AuthUsecase) FindByEmail(email string) (*ent.User, error) {
 	return u.userRepository.FindByEmail(email)
 }
 
-func (u *AuthUsecase) RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+func (u *AuthUsecase) RefreshToken(refreshToken string) (*models.TokenPair, error) {
 	return u.authRepository.RefreshToken(refreshToken)
 }
 
@@ -45,3 +50,7 @@ func (u *AuthUsecase) GetUserEmail(accessToken string) (string, error) {
 func (u *AuthUsecase) SignOut(accessToken string) error {
 	return u.authRepository.SignOut(accessToken)
 }
+
+func (u *AuthUsecase) PublicKeySet() ([]byte, error) {
+	return u.authRepository.PublicKeySet()
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// ForbiddenError is returned when the caller is not allowed to change the requested resource.
+type ForbiddenError struct {
+	Resource string
+	ID       string
+	UserID   string
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("user %s is not allowed to modify %s %s", e.UserID, e.Resource, e.ID)
+}
+
+// Authorizer checks that the caller owns the pets, posts and comments it modifies.
+type Authorizer struct {
+	petRepository     repository.PetRepository
+	postRepository    repository.PostRepository
+	commentRepository repository.CommentRepository
+}
+
+func NewAuthorizer(petRepository repository.PetRepository, postRepository repository.PostRepository, commentRepository repository.CommentRepository) *Authorizer {
+	return &Authorizer{
+		petRepository:     petRepository,
+		postRepository:    postRepository,
+		commentRepository: commentRepository,
+	}
+}
+
+// AuthorizePet allows only the owner of the pet.
+func (a *Authorizer) AuthorizePet(userID, petID string) error {
+	pet, err := a.petRepository.GetById(petID)
+	if err != nil {
+		return err
+	}
+	if !isUser(pet.Edges.Owner, userID) {
+		return &ForbiddenError{Resource: "pet", ID: petID, UserID: userID}
+	}
+	return nil
+}
+
+// AuthorizePost allows only the author of the post.
+func (a *Authorizer) AuthorizePost(userID, postID string) error {
+	post, err := a.postRepository.GetById(postID)
+	if err != nil {
+		return err
+	}
+	if !isUser(post.Edges.User, userID) {
+		return &ForbiddenError{Resource: "post", ID: postID, UserID: userID}
+	}
+	return nil
+}
+
+// AuthorizeCommentEdit allows only the author of the comment.
+func (a *Authorizer) AuthorizeCommentEdit(userID, commentID string) error {
+	comment, err := a.commentRepository.GetById(commentID)
+	if err != nil {
+		return err
+	}
+	if !isUser(comment.Edges.User, userID) {
+		return &ForbiddenError{Resource: "comment", ID: commentID, UserID: userID}
+	}
+	return nil
+}
+
+// AuthorizeCommentDeletion allows the author of the comment and the author of the post it was left on.
+func (a *Authorizer) AuthorizeCommentDeletion(userID, commentID string) error {
+	comment, err := a.commentRepository.GetById(commentID)
+	if err != nil {
+		return err
+	}
+	if isUser(comment.Edges.User, userID) {
+		return nil
+	}
+	if post := comment.Edges.Post; post != nil && isUser(post.Edges.User, userID) {
+		return nil
+	}
+	return &ForbiddenError{Resource: "comment", ID: commentID, UserID: userID}
+}
+
+func isUser(user *ent.User, userID string) bool {
+	return user != nil && user.ID.String() == userID
+}
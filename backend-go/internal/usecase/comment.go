@@ -2,41 +2,175 @@ package usecase
 
 import (
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 )
 
 type CommentUsecase struct {
 	commentRepository repository.CommentRepository
+	postRepository    repository.PostRepository
 	storageRepository repository.StorageRepository
+	authorizer        *Authorizer
+	notifier          *Notifier
+	streamer          *Streamer
 }
 
-func NewCommentUsecase(commentRepository repository.CommentRepository, storageRepository repository.StorageRepository) *CommentUsecase {
+func NewCommentUsecase(commentRepository repository.CommentRepository, postRepository repository.PostRepository, storageRepository repository.StorageRepository, authorizer *Authorizer, notifier *Notifier, streamer *Streamer) *CommentUsecase {
 	return &CommentUsecase{
 		commentRepository: commentRepository,
+		postRepository:    postRepository,
 		storageRepository: storageRepository,
+		authorizer:        authorizer,
+		notifier:          notifier,
+		streamer:          streamer,
 	}
 }
 
-func (u *CommentUsecase) Create(userID, postId, content string) error {
-	err := u.commentRepository.Create(userID, postId, content)
+// Create adds a comment, or a reply when parentId is set. Replies to a reply are attached to its top-level comment.
+// A post that does not exist or has been deleted is reported as not found.
+func (u *CommentUsecase) Create(userID, postId, parentId, content string) (models.CommentResponse, error) {
+	content, err := validateCommentContent(content)
 	if err != nil {
-		return err
+		return models.CommentResponse{}, err
 	}
-	return nil
+	if _, err := u.postRepository.GetById(postId); err != nil {
+		return models.CommentResponse{}, err
+	}
+	if parentId != "" {
+		parentId, err = u.threadRoot(postId, parentId)
+		if err != nil {
+			return models.CommentResponse{}, err
+		}
+	}
+
+	created, err := u.commentRepository.Create(userID, postId, parentId, content)
+	if err != nil {
+		return models.CommentResponse{}, err
+	}
+	u.notifier.NotifyComment(userID, postId)
+
+	// コメントは保存済みなので、読み込みに失敗しても作成結果を返す
+	resp, err := u.loadResponse(created.ID.String())
+	if err != nil {
+		log.Errorf("Failed to load created comment %v: %v", created.ID, err)
+		resp = models.NewCommentResponse(created, "", 0)
+		if parsedParentId, err := uuid.Parse(parentId); err == nil {
+			resp.ParentID = &parsedParentId
+		}
+		return resp, nil
+	}
+	u.streamer.CommentCreated(postId, resp)
+	return resp, nil
+}
+
+func (u *CommentUsecase) loadResponse(commentId string) (models.CommentResponse, error) {
+	comment, err := u.commentRepository.GetById(commentId)
+	if err != nil {
+		return models.CommentResponse{}, err
+	}
+	return u.convertToResponse(comment, 0)
+}
+
+// threadRoot returns the top-level comment a reply to parentId belongs under
+func (u *CommentUsecase) threadRoot(postId, parentId string) (string, error) {
+	if uuid.Validate(parentId) != nil {
+		return "", fmt.Errorf("%w: malformed comment ID %q", models.ErrInvalidCommentParent, parentId)
+	}
+	parent, err := u.commentRepository.GetById(parentId)
+	if ent.IsNotFound(err) {
+		return "", fmt.Errorf("%w: comment %s not found", models.ErrInvalidCommentParent, parentId)
+	}
+	if err != nil {
+		return "", err
+	}
+	if parent.Edges.Post == nil || parent.Edges.Post.ID.String() != postId {
+		return "", fmt.Errorf("%w: comment %s is not on post %s", models.ErrInvalidCommentParent, parentId, postId)
+	}
+	if root := parent.Edges.Parent; root != nil {
+		return root.ID.String(), nil
+	}
+	return parent.ID.String(), nil
+}
+
+// Update edits the content of the user's own comment and marks it as edited
+func (u *CommentUsecase) Update(userID, commentId, content string) (models.CommentResponse, error) {
+	content, err := validateCommentContent(content)
+	if err != nil {
+		return models.CommentResponse{}, err
+	}
+	if err := u.authorizer.AuthorizeCommentEdit(userID, commentId); err != nil {
+		return models.CommentResponse{}, err
+	}
+	if err := u.commentRepository.Update(commentId, content); err != nil {
+		return models.CommentResponse{}, err
+	}
+
+	comment, err := u.commentRepository.GetById(commentId)
+	if err != nil {
+		return models.CommentResponse{}, err
+	}
+	replyCounts, err := u.commentRepository.CountReplies([]uuid.UUID{comment.ID})
+	if err != nil {
+		return models.CommentResponse{}, err
+	}
+	resp, err := u.convertToResponse(comment, replyCounts[comment.ID])
+	if err != nil {
+		return models.CommentResponse{}, err
+	}
+	u.streamer.CommentUpdated(comment.Edges.Post.ID.String(), resp)
+	return resp, nil
 }
 
-func (u *CommentUsecase) Delete(commentId string) error {
-	err := u.commentRepository.Delete(commentId)
+func (u *CommentUsecase) Delete(userID, commentId string) error {
+	if err := u.authorizer.AuthorizeCommentDeletion(userID, commentId); err != nil {
+		return err
+	}
+	comment, err := u.commentRepository.GetById(commentId)
+	if err != nil {
+		return err
+	}
+	deletion, err := u.commentRepository.Delete(commentId)
 	if err != nil {
 		return err
 	}
+	post := comment.Edges.Post
+	if post == nil {
+		return nil
+	}
+
+	// 返信が残るコメントはプレースホルダーとして更新されたことを伝える
+	if deletion.Placeholder != nil {
+		u.streamPlaceholder(post.ID.String(), deletion.Placeholder)
+		return nil
+	}
+	u.streamer.CommentDeleted(post.ID.String(), comment.ID)
+	if deletion.RemovedParentID != nil {
+		u.streamer.CommentDeleted(post.ID.String(), *deletion.RemovedParentID)
+	}
 	return nil
 }
 
+// streamPlaceholder sends the placeholder left by a deletion. The comment is already deleted, so failures are only logged.
+func (u *CommentUsecase) streamPlaceholder(postId string, placeholder *ent.Comment) {
+	replyCounts, err := u.commentRepository.CountReplies([]uuid.UUID{placeholder.ID})
+	if err != nil {
+		log.Errorf("Failed to count replies of comment %v to stream: %v", placeholder.ID, err)
+		return
+	}
+	resp, err := u.convertToResponse(placeholder, replyCounts[placeholder.ID])
+	if err != nil {
+		log.Errorf("Failed to convert comment %v to stream: %v", placeholder.ID, err)
+		return
+	}
+	u.streamer.CommentUpdated(postId, resp)
+}
+
 func (u *CommentUsecase) Count(postId string) (int, error) {
 	count, err := u.commentRepository.Count(postId)
 	if err != nil {
@@ -45,7 +179,19 @@ func (u *CommentUsecase) Count(postId string) (int, error) {
 	return count, nil
 }
 
-func (u *CommentUsecase) convertToResponse(comment *ent.Comment) (models.CommentResponse, error) {
+func validateCommentContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" || utf8.RuneCountInString(content) > models.MaxCommentLength {
+		return "", models.ErrInvalidCommentContent
+	}
+	return content, nil
+}
+
+func (u *CommentUsecase) convertToResponse(comment *ent.Comment, replyCount int) (models.CommentResponse, error) {
+	// プレースホルダーは投稿者を表示しないので URL も不要
+	if comment.DeletedAt != nil {
+		return models.NewCommentResponse(comment, "", replyCount), nil
+	}
 	user := comment.Edges.User
 	if user == nil {
 		return models.CommentResponse{}, fmt.Errorf("user not found for comment ID %v", comment.ID)
@@ -56,25 +202,69 @@ func (u *CommentUsecase) convertToResponse(comment *ent.Comment) (models.Comment
 		return models.CommentResponse{}, fmt.Errorf("failed to get url for user %v: %w", user.ID, err)
 	}
 
-	return models.NewCommentResponse(comment, user, imageURL), nil
+	return models.NewCommentResponse(comment, imageURL, replyCount), nil
 }
 
-func (u *CommentUsecase) GetByPostId(postId string) ([]models.CommentResponse, error) {
-	comments, err := u.commentRepository.GetByPostId(postId)
+// GetByPostId returns one page of the post's top-level comments with their reply counts, and the cursor of the next page
+func (u *CommentUsecase) GetByPostId(postId string, cursor *models.Cursor, limit int) ([]models.CommentResponse, *models.Cursor, error) {
+	comments, err := u.commentRepository.ListTopLevel(postId, cursor, limit+1)
 	if err != nil {
 		log.Errorf("Failed to get Comments: %v", err)
-		return nil, err
+		return nil, nil, err
 	}
+	comments, nextCursor := commentPage(comments, limit)
 
-	var commentResponses []models.CommentResponse
-	for _, comment := range comments {
-		resp, err := u.convertToResponse(comment)
+	ids := make([]uuid.UUID, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+	replyCounts, err := u.commentRepository.CountReplies(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses, err := u.convertAll(comments, replyCounts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return responses, nextCursor, nil
+}
+
+// GetReplies returns one page of the replies to a comment, oldest first, and the cursor of the next page
+func (u *CommentUsecase) GetReplies(commentId string, cursor *models.Cursor, limit int) ([]models.CommentResponse, *models.Cursor, error) {
+	comments, err := u.commentRepository.ListReplies(commentId, cursor, limit+1)
+	if err != nil {
+		log.Errorf("Failed to get replies: %v", err)
+		return nil, nil, err
+	}
+	comments, nextCursor := commentPage(comments, limit)
+
+	responses, err := u.convertAll(comments, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return responses, nextCursor, nil
+}
+
+// commentPage trims the extra comment fetched to tell whether another page follows
+func commentPage(comments []*ent.Comment, limit int) ([]*ent.Comment, *models.Cursor) {
+	if len(comments) <= limit {
+		return comments, nil
+	}
+	comments = comments[:limit]
+	last := comments[limit-1]
+	return comments, &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+}
+
+func (u *CommentUsecase) convertAll(comments []*ent.Comment, replyCounts map[uuid.UUID]int) ([]models.CommentResponse, error) {
+	commentResponses := make([]models.CommentResponse, len(comments))
+	for i, comment := range comments {
+		resp, err := u.convertToResponse(comment, replyCounts[comment.ID])
 		if err != nil {
 			log.Errorf("Conversion error for comment ID %v: %v", comment.ID, err)
 			return nil, err
 		}
-		commentResponses = append(commentResponses, resp)
+		commentResponses[i] = resp
 	}
-
 	return commentResponses, nil
 }
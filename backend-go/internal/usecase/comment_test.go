@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// recordingEventHub records the published events and has no subscribers
+type recordingEventHub struct {
+	repository.EventHub
+	channels []string
+	events   []models.StreamEvent
+}
+
+func (h *recordingEventHub) Publish(channel string, event models.StreamEvent) error {
+	h.channels = append(h.channels, channel)
+	h.events = append(h.events, event)
+	return nil
+}
+
+// deletingCommentRepository serves one comment and returns a fixed result when it is deleted
+type deletingCommentRepository struct {
+	repository.CommentRepository
+	comment  *ent.Comment
+	deletion models.CommentDeletion
+	deleted  bool
+}
+
+func (r *deletingCommentRepository) GetById(commentId string) (*ent.Comment, error) {
+	if r.deleted || commentId != r.comment.ID.String() {
+		return nil, &ent.NotFoundError{}
+	}
+	return r.comment, nil
+}
+
+func (r *deletingCommentRepository) Delete(commentId string) (models.CommentDeletion, error) {
+	r.deleted = true
+	return r.deletion, nil
+}
+
+func (r *deletingCommentRepository) CountReplies(commentIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	return map[uuid.UUID]int{commentIds[0]: 2}, nil
+}
+
+func TestCommentUsecaseDelete(t *testing.T) {
+	author := &ent.User{ID: uuid.New(), Name: "author"}
+	post := &ent.Post{ID: uuid.New()}
+	comment := &ent.Comment{ID: uuid.New(), Content: "hello", CreatedAt: time.Now()}
+	comment.Edges.User = author
+	comment.Edges.Post = post
+	deletedAt := time.Now()
+	placeholder := &ent.Comment{ID: comment.ID, CreatedAt: comment.CreatedAt, DeletedAt: &deletedAt}
+	parentId := uuid.New()
+
+	type wantEvent struct {
+		eventType string
+		id        uuid.UUID
+	}
+	tests := []struct {
+		name     string
+		deletion models.CommentDeletion
+		want     []wantEvent
+	}{
+		{"comment without replies", models.CommentDeletion{}, []wantEvent{{models.StreamEventCommentDeleted, comment.ID}}},
+		{"comment kept as a placeholder", models.CommentDeletion{Placeholder: placeholder}, []wantEvent{{models.StreamEventCommentUpdated, comment.ID}}},
+		{
+			"last reply of a placeholder",
+			models.CommentDeletion{RemovedParentID: &parentId},
+			[]wantEvent{{models.StreamEventCommentDeleted, comment.ID}, {models.StreamEventCommentDeleted, parentId}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comments := &deletingCommentRepository{comment: comment, deletion: tt.deletion}
+			hub := &recordingEventHub{}
+			u := NewCommentUsecase(comments, nil, nil, NewAuthorizer(nil, nil, comments), nil, NewStreamer(hub))
+
+			if err := u.Delete(author.ID.String(), comment.ID.String()); err != nil {
+				t.Fatalf("Delete returned error: %v", err)
+			}
+			if len(hub.events) != len(tt.want) {
+				t.Fatalf("Delete published %d events, want %d", len(hub.events), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if hub.channels[i] != models.PostChannel(post.ID.String()) {
+					t.Errorf("event %d published to %q, want the post channel", i, hub.channels[i])
+				}
+				var data struct {
+					ID         uuid.UUID `json:"id"`
+					Deleted    bool      `json:"deleted"`
+					ReplyCount int       `json:"replyCount"`
+				}
+				if err := json.Unmarshal(hub.events[i].Data, &data); err != nil {
+					t.Fatalf("failed to decode event %d: %v", i, err)
+				}
+				if hub.events[i].Type != want.eventType || data.ID != want.id {
+					t.Errorf("event %d = %s %v, want %s %v", i, hub.events[i].Type, data.ID, want.eventType, want.id)
+				}
+				if want.eventType == models.StreamEventCommentUpdated && (!data.Deleted || data.ReplyCount != 2) {
+					t.Errorf("placeholder event = %s, want a deleted comment with 2 replies", hub.events[i].Data)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// streakLookbackDays bounds how far back the completion streak is counted
+const streakLookbackDays = 366
+
+type DailyTaskUsecase struct {
+	dailyTaskRepository repository.DailyTaskRepository
+	taskTypeRepository  repository.TaskTypeRepository
+}
+
+func NewDailyTaskUsecase(dailyTaskRepository repository.DailyTaskRepository, taskTypeRepository repository.TaskTypeRepository) *DailyTaskUsecase {
+	return &DailyTaskUsecase{
+		dailyTaskRepository: dailyTaskRepository,
+		taskTypeRepository:  taskTypeRepository,
+	}
+}
+
+// GetToday returns today's task of the user, or nil when none has been assigned yet
+func (u *DailyTaskUsecase) GetToday(userId string, lang string) (*models.DailyTaskStatusResponse, error) {
+	dailyTask, err := u.dailyTaskRepository.GetLatestByUser(userId, models.StartOfTaskDay(time.Now()))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	taskTypes, err := u.taskTypeRepository.List(true)
+	if err != nil {
+		return nil, err
+	}
+	resp := models.NewDailyTaskStatusResponse(dailyTask)
+	resp.Describe(taskTypesByType(taskTypes)[string(dailyTask.Type)], lang)
+	return &resp, nil
+}
+
+// GetHistory returns one page of the user's tasks, newest first, and the cursor of the next page
+func (u *DailyTaskUsecase) GetHistory(userId string, lang string, cursor *models.Cursor, limit int) ([]models.DailyTaskStatusResponse, *models.Cursor, error) {
+	dailyTasks, err := u.dailyTaskRepository.GetByUser(userId, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *models.Cursor
+	if len(dailyTasks) > limit {
+		dailyTasks = dailyTasks[:limit]
+		last := dailyTasks[len(dailyTasks)-1]
+		nextCursor = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	taskTypes, err := u.taskTypeRepository.List(true)
+	if err != nil {
+		return nil, nil, err
+	}
+	byType := taskTypesByType(taskTypes)
+
+	responses := make([]models.DailyTaskStatusResponse, len(dailyTasks))
+	for i, dailyTask := range dailyTasks {
+		responses[i] = models.NewDailyTaskStatusResponse(dailyTask)
+		responses[i].Describe(byType[string(dailyTask.Type)], lang)
+	}
+	return responses, nextCursor, nil
+}
+
+// GetStreak counts the consecutive days up to today on which the user completed their task.
+// Today's task still being open does not break the streak.
+func (u *DailyTaskUsecase) GetStreak(userId string) (int, error) {
+	today := models.StartOfTaskDay(time.Now())
+	dates, err := u.dailyTaskRepository.GetCompletedDates(userId, today.AddDate(0, 0, -streakLookbackDays))
+	if err != nil {
+		return 0, err
+	}
+
+	completed := make(map[time.Time]bool, len(dates))
+	for _, date := range dates {
+		completed[models.StartOfTaskDay(date)] = true
+	}
+
+	day := today
+	if !completed[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+	streak := 0
+	for completed[day] {
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+	return streak, nil
+}
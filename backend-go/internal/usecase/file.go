@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// FileUsecase serves the objects of a storage backend that the API hosts itself, such as the local filesystem
+type FileUsecase struct {
+	storageRepository    repository.StorageRepository
+	signedFileRepository repository.SignedFileRepository
+}
+
+func NewFileUsecase(storageRepository repository.StorageRepository, signedFileRepository repository.SignedFileRepository) *FileUsecase {
+	return &FileUsecase{
+		storageRepository:    storageRepository,
+		signedFileRepository: signedFileRepository,
+	}
+}
+
+// Read returns the object behind a URL issued by GetUrl
+func (u *FileUsecase) Read(fileKey, expires, signature string) ([]byte, string, error) {
+	if err := u.signedFileRepository.VerifySignature("GET", fileKey, "", expires, signature); err != nil {
+		return nil, "", err
+	}
+	object, err := u.storageRepository.StatImage(fileKey)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := u.storageRepository.GetObject(fileKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, object.ContentType, nil
+}
+
+// Write stores an object uploaded to a URL issued by PresignUpload.
+// The caller reads at most models.MaxImageSize+1 bytes so that oversized uploads can be rejected here.
+func (u *FileUsecase) Write(fileKey, contentType, expires, signature string, data []byte) error {
+	if err := u.signedFileRepository.VerifySignature("PUT", fileKey, contentType, expires, signature); err != nil {
+		return err
+	}
+	if len(data) > models.MaxImageSize {
+		return fmt.Errorf("%w: more than %d bytes", models.ErrUploadTooLarge, models.MaxImageSize)
+	}
+	return u.storageRepository.PutObject(fileKey, contentType, data)
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"golang.org/x/image/draw"
+
+	// HEIC と WebP のデコーダーを image.Decode に登録する
+	_ "github.com/gen2brain/heic"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// maxImagePixels guards against images that are small on disk but huge when decoded.
+	// It admits the 24 megapixel photos of current phones, which decode to about 100MB as RGBA.
+	maxImagePixels = 25_000_000
+	jpegQuality    = 88
+)
+
+// processedImage is an encoded image ready to be stored
+type processedImage struct {
+	key         string
+	contentType string
+	data        []byte
+}
+
+// processImage decodes the upload, applies its EXIF orientation and re-encodes it without metadata.
+// It returns the original under models.OriginalImageKey(baseKey) followed by the resized variants.
+// PNG stays PNG to keep transparency; every other format, including HEIC, becomes JPEG.
+func processImage(data []byte, baseKey string) ([]processedImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrUnsupportedImageType, err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", models.ErrUploadTooLarge, config.Width, config.Height)
+	}
+
+	// デコードした画像はそのまま使い、回転が必要な場合だけ RGBA にコピーする
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrUnsupportedImageType, err)
+	}
+	if format == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	var images []processedImage
+	if format == "png" {
+		encoded, err := encodePNG(img)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, processedImage{models.OriginalImageKey(baseKey, ".png"), "image/png", encoded})
+	} else {
+		encoded, err := encodeJPEG(img)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, processedImage{models.OriginalImageKey(baseKey, ".jpg"), "image/jpeg", encoded})
+	}
+
+	for _, variant := range models.ImageVariants {
+		encoded, err := encodeJPEG(resize(img, variant.MaxSize))
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, processedImage{models.VariantImageKey(baseKey, variant), "image/jpeg", encoded})
+	}
+	return images, nil
+}
+
+// resize scales the image down so that its longer side is at most maxSize
+func resize(src image.Image, maxSize int) image.Image {
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	if width <= maxSize && height <= maxSize {
+		return src
+	}
+	if width >= height {
+		height = max(1, height*maxSize/width)
+		width = maxSize
+	} else {
+		width = max(1, width*maxSize/height)
+		height = maxSize
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.BiLinear.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Src, nil)
+	return dst
+}
+
+func encodeJPEG(img image.Image) ([]byte, error) {
+	// JPEG は透過を持てないため、透過のある画像だけ白背景に合成する
+	if opaque, ok := img.(interface{ Opaque() bool }); !ok || !opaque.Opaque() {
+		flattened := image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+		draw.Draw(flattened, flattened.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(flattened, flattened.Bounds(), img, img.Bounds().Min, draw.Over)
+		img = flattened
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode jpeg: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// jpegOrientation returns the EXIF orientation (1〜8) of a JPEG, or 1 when it has none
+func jpegOrientation(data []byte) int {
+	r := bytes.NewReader(data)
+	var marker [2]byte
+	if _, err := io.ReadFull(r, marker[:]); err != nil || marker != [2]byte{0xFF, 0xD8} {
+		return 1
+	}
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil || header[0] != 0xFF {
+			return 1
+		}
+		// SOS 以降は画像データのため、メタデータはそれより前にしかない
+		if header[1] == 0xDA {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(header[2:])) - 2
+		if length < 0 {
+			return 1
+		}
+		segment := make([]byte, length)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return 1
+		}
+		if header[1] == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+	}
+}
+
+// exifOrientation reads the orientation tag (0x0112) from IFD0 of a TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < entries; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+	return 1
+}
+
+// orient transforms the image so that it displays upright without the EXIF orientation.
+// The pixels are copied straight into the rotated image, without an intermediate RGBA copy of the source.
+func orient(src image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	pixel := rgbaPixelReader(src)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 左右反転
+				dx, dy = width-1-x, y
+			case 3: // 180度回転
+				dx, dy = width-1-x, height-1-y
+			case 4: // 上下反転
+				dx, dy = x, height-1-y
+			case 5: // 転置
+				dx, dy = y, x
+			case 6: // 時計回りに90度回転
+				dx, dy = height-1-y, x
+			case 7: // 反転した転置
+				dx, dy = height-1-y, width-1-x
+			case 8: // 反時計回りに90度回転
+				dx, dy = y, width-1-x
+			}
+			di := dst.PixOffset(dx, dy)
+			pixel(bounds.Min.X+x, bounds.Min.Y+y, dst.Pix[di:di+4])
+		}
+	}
+	return dst
+}
+
+// rgbaPixelReader returns a function writing the premultiplied RGBA value of a pixel of src to dst,
+// with fast paths for the RGBA and YCbCr images the JPEG decoder produces
+func rgbaPixelReader(src image.Image) func(x, y int, dst []byte) {
+	switch src := src.(type) {
+	case *image.RGBA:
+		return func(x, y int, dst []byte) {
+			si := src.PixOffset(x, y)
+			copy(dst, src.Pix[si:si+4])
+		}
+	case *image.YCbCr:
+		return func(x, y int, dst []byte) {
+			c := src.YCbCrAt(x, y)
+			dst[0], dst[1], dst[2] = color.YCbCrToRGB(c.Y, c.Cb, c.Cr)
+			dst[3] = 0xFF
+		}
+	default:
+		return func(x, y int, dst []byte) {
+			c := color.RGBAModel.Convert(src.At(x, y)).(color.RGBA)
+			dst[0], dst[1], dst[2], dst[3] = c.R, c.G, c.B, c.A
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+// exifSegment builds an APP1 segment whose IFD0 holds only the orientation tag
+func exifSegment(order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], 0x0112)
+	order.PutUint16(tiff[12:], 3) // SHORT
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], orientation)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// withSegment inserts the segment right after the SOI marker of the JPEG
+func withSegment(jpegData, segment []byte) []byte {
+	data := append([]byte{}, jpegData[:2]...)
+	data = append(data, segment...)
+	return append(data, jpegData[2:]...)
+}
+
+func encodeTestJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x * 255 / width), uint8(y * 255 / height), 128, 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("failed to encode test jpeg: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestJpegOrientation(t *testing.T) {
+	plain := encodeTestJPEG(t, 8, 4)
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"no exif", plain, 1},
+		{"little endian rotate 90", withSegment(plain, exifSegment(binary.LittleEndian, 6)), 6},
+		{"big endian rotate 90", withSegment(plain, exifSegment(binary.BigEndian, 6)), 6},
+		{"big endian rotate 180", withSegment(plain, exifSegment(binary.BigEndian, 3)), 3},
+		{"mirrored transpose", withSegment(plain, exifSegment(binary.LittleEndian, 5)), 5},
+		{"upright", withSegment(plain, exifSegment(binary.LittleEndian, 1)), 1},
+		{"out of range", withSegment(plain, exifSegment(binary.LittleEndian, 9)), 1},
+		{"zero", withSegment(plain, exifSegment(binary.BigEndian, 0)), 1},
+		{"truncated segment", withSegment(plain, exifSegment(binary.LittleEndian, 6))[:20], 1},
+		{"not a jpeg", []byte("\x89PNG\r\n\x1a\n"), 1},
+		{"empty", nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// 3x2 の画像で、各画素を R 値で識別する
+	//   1 2 3
+	//   4 5 6
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for i := 0; i < 6; i++ {
+		src.Set(i%3, i/3, color.RGBA{uint8(i + 1), 0, 0, 255})
+	}
+	tests := []struct {
+		orientation int
+		want        [][]uint8
+	}{
+		{1, [][]uint8{{1, 2, 3}, {4, 5, 6}}},
+		{2, [][]uint8{{3, 2, 1}, {6, 5, 4}}},
+		{3, [][]uint8{{6, 5, 4}, {3, 2, 1}}},
+		{4, [][]uint8{{4, 5, 6}, {1, 2, 3}}},
+		{5, [][]uint8{{1, 4}, {2, 5}, {3, 6}}},
+		{6, [][]uint8{{4, 1}, {5, 2}, {6, 3}}},
+		{7, [][]uint8{{6, 3}, {5, 2}, {4, 1}}},
+		{8, [][]uint8{{3, 6}, {2, 5}, {1, 4}}},
+	}
+	for _, tt := range tests {
+		got := orient(src, tt.orientation)
+		bounds := got.Bounds()
+		if bounds.Dx() != len(tt.want[0]) || bounds.Dy() != len(tt.want) {
+			t.Errorf("orientation %d: size = %dx%d, want %dx%d", tt.orientation, bounds.Dx(), bounds.Dy(), len(tt.want[0]), len(tt.want))
+			continue
+		}
+		for y, row := range tt.want {
+			for x, want := range row {
+				r, _, _, _ := got.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+				if uint8(r>>8) != want {
+					t.Errorf("orientation %d: pixel (%d, %d) = %d, want %d", tt.orientation, x, y, r>>8, want)
+				}
+			}
+		}
+	}
+}
+
+func TestOrientYCbCr(t *testing.T) {
+	// JPEG のデコード結果 (YCbCr) も RGBA と同じ向きに変換される
+	decoded, err := jpeg.Decode(bytes.NewReader(encodeTestJPEG(t, 8, 4)))
+	if err != nil {
+		t.Fatalf("failed to decode test jpeg: %v", err)
+	}
+	if _, ok := decoded.(*image.YCbCr); !ok {
+		t.Fatalf("decoded %T, want *image.YCbCr", decoded)
+	}
+	got := orient(decoded, 6)
+	if got.Bounds().Dx() != 4 || got.Bounds().Dy() != 8 {
+		t.Fatalf("size = %dx%d, want 4x8", got.Bounds().Dx(), got.Bounds().Dy())
+	}
+	// 時計回りに回転すると左上の画素は右上に移る
+	want := color.RGBAModel.Convert(decoded.At(0, 0)).(color.RGBA)
+	if c := got.At(3, 0).(color.RGBA); c != want {
+		t.Errorf("pixel (3, 0) = %v, want %v", c, want)
+	}
+}
+
+func TestProcessImage(t *testing.T) {
+	landscape := encodeTestJPEG(t, 400, 200)
+	transparent := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+	var pngBuf bytes.Buffer
+	if err := png.Encode(&pngBuf, transparent); err != nil {
+		t.Fatalf("failed to encode test png: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		data         []byte
+		wantOriginal string
+		wantWidth    int
+		wantHeight   int
+	}{
+		{"jpeg", landscape, "base/original.jpg", 400, 200},
+		{"jpeg rotated by exif", withSegment(landscape, exifSegment(binary.LittleEndian, 6)), "base/original.jpg", 200, 400},
+		{"png keeps its format", pngBuf.Bytes(), "base/original.png", 40, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			images, err := processImage(tt.data, "base")
+			if err != nil {
+				t.Fatalf("processImage returned error: %v", err)
+			}
+			if len(images) != 1+len(models.ImageVariants) {
+				t.Fatalf("processImage returned %d images, want %d", len(images), 1+len(models.ImageVariants))
+			}
+			if images[0].key != tt.wantOriginal {
+				t.Errorf("original key = %q, want %q", images[0].key, tt.wantOriginal)
+			}
+			config, _, err := image.DecodeConfig(bytes.NewReader(images[0].data))
+			if err != nil {
+				t.Fatalf("failed to decode original: %v", err)
+			}
+			if config.Width != tt.wantWidth || config.Height != tt.wantHeight {
+				t.Errorf("original size = %dx%d, want %dx%d", config.Width, config.Height, tt.wantWidth, tt.wantHeight)
+			}
+			for _, image := range images {
+				if bytes.Contains(image.data, []byte("Exif\x00\x00")) {
+					t.Errorf("%s still has EXIF metadata", image.key)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessImageRejects(t *testing.T) {
+	// 画素数はヘッダーだけで判定されるので、巨大な画像を実際に作る必要はない
+	huge := image.NewGray(image.Rect(0, 0, 1, 1))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, huge); err != nil {
+		t.Fatalf("failed to encode test png: %v", err)
+	}
+	data := buf.Bytes()
+	// IHDR の幅と高さを書き換える
+	binary.BigEndian.PutUint32(data[16:], 10000)
+	binary.BigEndian.PutUint32(data[20:], 10000)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"not an image", []byte("hello")},
+		{"too many pixels", data},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := processImage(tt.data, "base"); err == nil {
+				t.Error("processImage returned no error")
+			}
+		})
+	}
+}
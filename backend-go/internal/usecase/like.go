@@ -1,24 +1,54 @@
 package usecase
 
-import "github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
 
 type LikeUsecase struct {
 	likeRepository repository.LikeRepository
+	notifier       *Notifier
+	streamer       *Streamer
 }
 
-func NewLikeUsecase(likeRepository repository.LikeRepository) *LikeUsecase {
+func NewLikeUsecase(likeRepository repository.LikeRepository, notifier *Notifier, streamer *Streamer) *LikeUsecase {
 	return &LikeUsecase{
 		likeRepository: likeRepository,
+		notifier:       notifier,
+		streamer:       streamer,
 	}
 }
 
 func (u *LikeUsecase) Create(userID, postId string) error {
-	err := u.likeRepository.Create(userID, postId)
-	return err
+	if err := u.likeRepository.Create(userID, postId); err != nil {
+		return err
+	}
+	u.notifier.NotifyLike(userID, postId)
+	u.streamCount(postId)
+	return nil
 }
 
 func (u *LikeUsecase) Delete(userID, postId string) error {
-	return u.likeRepository.Delete(userID, postId)
+	if err := u.likeRepository.Delete(userID, postId); err != nil {
+		return err
+	}
+	u.streamCount(postId)
+	return nil
+}
+
+// streamCount sends the new like count to the clients watching the post
+func (u *LikeUsecase) streamCount(postId string) {
+	parsedPostId, err := uuid.Parse(postId)
+	if err != nil {
+		return
+	}
+	count, err := u.likeRepository.Count(postId)
+	if err != nil {
+		log.Errorf("Failed to count likes of post %s to stream: %v", postId, err)
+		return
+	}
+	u.streamer.LikeCount(parsedPostId, count)
 }
 
 func (u *LikeUsecase) Count(postId string) (int, error) {
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/labstack/gommon/log"
+)
+
+// Notifier records notifications for likes, comments and follows.
+// Failures are only logged, since the action itself has already succeeded.
+type Notifier struct {
+	notificationRepository repository.NotificationRepository
+	postRepository         repository.PostRepository
+	pushWorker             *PushDeliveryWorker
+	streamer               *Streamer
+}
+
+// NewNotifier hands every recorded notification to pushWorker for delivery to the recipient's devices,
+// and streams it to the recipient's open clients
+func NewNotifier(notificationRepository repository.NotificationRepository, postRepository repository.PostRepository, pushWorker *PushDeliveryWorker, streamer *Streamer) *Notifier {
+	return &Notifier{
+		notificationRepository: notificationRepository,
+		postRepository:         postRepository,
+		pushWorker:             pushWorker,
+		streamer:               streamer,
+	}
+}
+
+func (n *Notifier) NotifyLike(actorId, postId string) {
+	n.notifyPostOwner(notification.KindLike, actorId, postId)
+}
+
+func (n *Notifier) NotifyComment(actorId, postId string) {
+	n.notifyPostOwner(notification.KindComment, actorId, postId)
+}
+
+func (n *Notifier) NotifyFollow(actorId, followedId string) {
+	n.notify(notification.KindFollow, actorId, followedId, "")
+}
+
+func (n *Notifier) notifyPostOwner(kind notification.Kind, actorId, postId string) {
+	post, err := n.postRepository.GetById(postId)
+	if err != nil {
+		log.Errorf("Failed to get post %s to notify %s: %v", postId, kind, err)
+		return
+	}
+	n.notify(kind, actorId, post.Edges.User.ID.String(), postId)
+}
+
+func (n *Notifier) notify(kind notification.Kind, actorId, recipientId, postId string) {
+	// 自分の投稿へのいいね等は通知しない
+	if actorId == recipientId {
+		return
+	}
+	if err := n.notificationRepository.Record(kind, actorId, recipientId, postId); err != nil {
+		log.Errorf("Failed to record %s notification for user %s: %v", kind, recipientId, err)
+		return
+	}
+	n.pushWorker.Enqueue(models.NotificationEvent{
+		Kind:        kind,
+		ActorID:     actorId,
+		RecipientID: recipientId,
+		PostID:      postId,
+	})
+
+	unreadCount, err := n.notificationRepository.CountUnread(recipientId)
+	if err != nil {
+		log.Errorf("Failed to count unread notifications of user %s: %v", recipientId, err)
+		return
+	}
+	n.streamer.Notification(recipientId, models.NotificationStreamEvent{
+		Kind:        string(kind),
+		ActorID:     actorId,
+		PostID:      postId,
+		UnreadCount: unreadCount,
+	})
+}
+
+type NotificationUsecase struct {
+	notificationRepository repository.NotificationRepository
+	storageRepository      repository.StorageRepository
+}
+
+func NewNotificationUsecase(notificationRepository repository.NotificationRepository, storageRepository repository.StorageRepository) *NotificationUsecase {
+	return &NotificationUsecase{
+		notificationRepository: notificationRepository,
+		storageRepository:      storageRepository,
+	}
+}
+
+// List returns one page of the user's notifications, and the cursor of the next page
+func (u *NotificationUsecase) List(userId string, cursor *models.Cursor, limit int) ([]models.NotificationResponse, *models.Cursor, error) {
+	notifications, err := u.notificationRepository.List(userId, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *models.Cursor
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		last := notifications[limit-1]
+		nextCursor = &models.Cursor{CreatedAt: last.UpdatedAt, ID: last.ID}
+	}
+
+	responses := make([]models.NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		actorImageURL := ""
+		if n.Edges.Actor.IconImageKey != "" {
+			actorImageURL, err = u.storageRepository.GetUrl(n.Edges.Actor.IconImageKey)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get url for user %v: %w", n.Edges.Actor.ID, err)
+			}
+		}
+		postThumbnailURL := ""
+		if n.Edges.Post != nil {
+			postThumbnailURL, err = u.storageRepository.GetUrl(models.ThumbnailKey(n.Edges.Post.ImageKey))
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get url for post %v: %w", n.Edges.Post.ID, err)
+			}
+		}
+		responses[i] = models.NewNotificationResponse(n, actorImageURL, postThumbnailURL)
+	}
+	return responses, nextCursor, nil
+}
+
+func (u *NotificationUsecase) CountUnread(userId string) (int, error) {
+	return u.notificationRepository.CountUnread(userId)
+}
+
+func (u *NotificationUsecase) MarkRead(userId, notificationId string) error {
+	return u.notificationRepository.MarkRead(userId, notificationId)
+}
+
+func (u *NotificationUsecase) MarkAllRead(userId string) error {
+	return u.notificationRepository.MarkAllRead(userId)
+}
@@ -7,11 +7,13 @@ import (
 
 type PetUsecase struct {
 	petRepository repository.PetRepository
+	authorizer    *Authorizer
 }
 
-func NewPetUsecase(petRepository repository.PetRepository) *PetUsecase {
+func NewPetUsecase(petRepository repository.PetRepository, authorizer *Authorizer) *PetUsecase {
 	return &PetUsecase{
 		petRepository: petRepository,
+		authorizer:    authorizer,
 	}
 }
 
@@ -23,10 +25,16 @@ func (u *PetUsecase) Create(name, petType, species, birthDay, fileKey, userID st
 	return u.petRepository.Create(name, petType, species, birthDay, fileKey, userID)
 }
 
-func (u *PetUsecase) Update(petId, name, petType, species, birthDay string) error {
+func (u *PetUsecase) Update(userID, petId, name, petType, species, birthDay string) error {
+	if err := u.authorizer.AuthorizePet(userID, petId); err != nil {
+		return err
+	}
 	return u.petRepository.Update(petId, name, petType, species, birthDay)
 }
 
-func (u *PetUsecase) Delete(petId string) error {
+func (u *PetUsecase) Delete(userID, petId string) error {
+	if err := u.authorizer.AuthorizePet(userID, petId); err != nil {
+		return err
+	}
 	return u.petRepository.Delete(petId)
 }
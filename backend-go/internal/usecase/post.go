@@ -1,32 +1,225 @@
 package usecase
 
 import (
+	"time"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/labstack/gommon/log"
 )
 
 type PostUsecase struct {
-	postRepository repository.PostRepository
+	postRepository        repository.PostRepository
+	dailyTaskRepository   repository.DailyTaskRepository
+	storageRepository     repository.StorageRepository
+	taskScoringRepository repository.TaskScoringRepository
+	taskScoreThreshold    float64
+	// recommendationRepository is nil when the recommendation service is not configured
+	recommendationRepository repository.RecommendationRepository
+	authorizer               *Authorizer
+	presenter                *postPresenter
 }
 
-func NewPostUsecase(postRepository repository.PostRepository) *PostUsecase {
+func NewPostUsecase(postRepository repository.PostRepository, dailyTaskRepository repository.DailyTaskRepository, storageRepository repository.StorageRepository, likeRepository repository.LikeRepository, commentRepository repository.CommentRepository, taskScoringRepository repository.TaskScoringRepository, taskScoreThreshold float64, recommendationRepository repository.RecommendationRepository, authorizer *Authorizer) *PostUsecase {
 	return &PostUsecase{
-		postRepository: postRepository,
+		postRepository:           postRepository,
+		dailyTaskRepository:      dailyTaskRepository,
+		storageRepository:        storageRepository,
+		taskScoringRepository:    taskScoringRepository,
+		taskScoreThreshold:       taskScoreThreshold,
+		recommendationRepository: recommendationRepository,
+		authorizer:               authorizer,
+		presenter:                newPostPresenter(storageRepository, likeRepository, commentRepository),
+	}
+}
+
+func (u *PostUsecase) GetAllPosts(viewerId string) ([]models.PostResponse, error) {
+	posts, err := u.postRepository.GetAllPosts()
+	if err != nil {
+		return nil, err
+	}
+	return u.presenter.present(posts, viewerId)
+}
+
+// GetById returns a single post as seen by the viewer, who may be empty for anonymous requests
+func (u *PostUsecase) GetById(viewerId, postId string) (models.PostResponse, error) {
+	post, err := u.postRepository.GetById(postId)
+	if err != nil {
+		return models.PostResponse{}, err
+	}
+	responses, err := u.presenter.present([]*ent.Post{post}, viewerId)
+	if err != nil {
+		return models.PostResponse{}, err
+	}
+	return responses[0], nil
+}
+
+// GetTimeline returns one page of posts, newest first, and the cursor of the next page.
+// The next cursor is nil when there are no more posts. The following mode requires a viewer.
+func (u *PostUsecase) GetTimeline(viewerId string, following bool, cursor *models.Cursor, limit int) ([]models.PostResponse, *models.Cursor, error) {
+	var (
+		posts []*ent.Post
+		err   error
+	)
+	// 次のページの有無を判定するため 1 件多く取得する
+	if following {
+		posts, err = u.postRepository.GetFollowingTimeline(viewerId, cursor, limit+1)
+	} else {
+		posts, err = u.postRepository.GetTimeline(cursor, limit+1)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *models.Cursor
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[len(posts)-1]
+		nextCursor = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	postResponses, err := u.presenter.present(posts, viewerId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return postResponses, nextCursor, nil
+}
+
+// GetSimilar returns posts with images like that of the given post, excluding the viewer's own posts
+func (u *PostUsecase) GetSimilar(viewerId, postId string, limit int) ([]models.PostResponse, error) {
+	if _, err := u.postRepository.GetById(postId); err != nil {
+		return nil, err
+	}
+	posts, err := u.postRepository.GetSimilarPosts(postId, viewerId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return u.presenter.present(posts, viewerId)
+}
+
+// GetRecommended returns one page of the timeline ranked by the recommendation service, continuing at offset.
+// On the first page, anonymous viewers and failures of the service get the latest posts instead, which are then
+// paged by cursor. Later pages never fall back, so that the first page of the latest posts is not served again.
+func (u *PostUsecase) GetRecommended(viewer *ent.User, offset int, cursor *models.Cursor, limit int) (models.RecommendedTimeline, error) {
+	viewerId := ""
+	if viewer != nil {
+		viewerId = viewer.ID.String()
+	}
+
+	if cursor == nil {
+		if viewer != nil && u.recommendationRepository != nil {
+			timeline, err := u.getRecommended(viewer, offset, limit)
+			if err == nil {
+				return timeline, nil
+			}
+			if offset > 0 {
+				return models.RecommendedTimeline{}, err
+			}
+			log.Errorf("Failed to get recommended timeline, falling back to the latest posts: %v", err)
+		} else if offset > 0 {
+			return models.RecommendedTimeline{Posts: []models.PostResponse{}}, nil
+		}
+	}
+
+	posts, nextCursor, err := u.GetTimeline(viewerId, false, cursor, limit)
+	if err != nil {
+		return models.RecommendedTimeline{}, err
+	}
+	return models.RecommendedTimeline{Posts: posts, NextCursor: nextCursor}, nil
+}
+
+func (u *PostUsecase) getRecommended(viewer *ent.User, offset, limit int) (models.RecommendedTimeline, error) {
+	indexes, err := u.recommendationRepository.RecommendPostIndexes(viewer.Index, offset, limit)
+	if err != nil {
+		return models.RecommendedTimeline{}, err
 	}
+	posts, err := u.postRepository.GetByIndexes(indexes)
+	if err != nil {
+		return models.RecommendedTimeline{}, err
+	}
+	postResponses, err := u.presenter.present(posts, viewer.ID.String())
+	if err != nil {
+		return models.RecommendedTimeline{}, err
+	}
+
+	timeline := models.RecommendedTimeline{Posts: postResponses, Recommended: true}
+	// 推薦結果が limit 件に満たない場合は最後のページ
+	if len(indexes) == limit {
+		next := offset + limit
+		timeline.NextOffset = &next
+	}
+	return timeline, nil
 }
 
-func (u *PostUsecase) GetAllPosts() ([]*ent.Post, error) {
-	return u.postRepository.GetAllPosts()
+// CreatePost creates the post and, when it answers a daily task, scores it against the task.
+// The task is completed only when the score reaches the threshold. Once the post is saved,
+// scoring failures are logged and leave the task open instead of failing the request.
+func (u *PostUsecase) CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, *models.TaskResult, error) {
+	var dailyTask *ent.DailyTask
+	if dailyTaskId != nil {
+		var err error
+		dailyTask, err = u.validateDailyTask(userId, *dailyTaskId)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	post, err := u.postRepository.CreatePost(caption, userId, fileKey, models.ParseHashtags(caption))
+	if err != nil {
+		return nil, nil, err
+	}
+	if dailyTask == nil {
+		return post, nil, nil
+	}
+
+	result := &models.TaskResult{}
+	score, err := u.taskScoringRepository.Score(dailyTask.Type, fileKey)
+	if err != nil {
+		log.Errorf("Failed to score post %v for daily task %v: %v", post.ID, dailyTask.ID, err)
+		return post, result, nil
+	}
+	result.Score = &score
+	post.TaskScore = &score
+
+	completed := score >= u.taskScoreThreshold
+	err = u.postRepository.RecordTaskScore(post.ID.String(), *dailyTaskId, score, completed)
+	if err != nil {
+		log.Errorf("Failed to record task score of post %v: %v", post.ID, err)
+		return post, result, nil
+	}
+	result.Completed = completed
+	return post, result, nil
 }
 
-func (u *PostUsecase) CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	return u.postRepository.CreatePost(caption, userId, fileKey, dailyTaskId)
+// validateDailyTask checks that the task belongs to the user, is today's task and has no post yet
+func (u *PostUsecase) validateDailyTask(userId, dailyTaskId string) (*ent.DailyTask, error) {
+	dailyTask, err := u.dailyTaskRepository.GetById(dailyTaskId)
+	if err != nil {
+		return nil, err
+	}
+	if !isUser(dailyTask.Edges.User, userId) {
+		return nil, &ForbiddenError{Resource: "daily task", ID: dailyTaskId, UserID: userId}
+	}
+	if dailyTask.CreatedAt.Before(models.StartOfTaskDay(time.Now())) {
+		return nil, models.ErrDailyTaskNotToday
+	}
+	if dailyTask.Edges.Post != nil {
+		return nil, models.ErrDailyTaskCompleted
+	}
+	return dailyTask, nil
 }
 
-func (u *PostUsecase) UpdatePost(postId, caption string) error {
-	return u.postRepository.UpdatePost(postId, caption)
+func (u *PostUsecase) UpdatePost(userId, postId, caption string) error {
+	if err := u.authorizer.AuthorizePost(userId, postId); err != nil {
+		return err
+	}
+	return u.postRepository.UpdatePost(postId, caption, models.ParseHashtags(caption))
 }
 
-func (u *PostUsecase) DeletePost(postId string) error {
+func (u *PostUsecase) DeletePost(userId, postId string) error {
+	if err := u.authorizer.AuthorizePost(userId, postId); err != nil {
+		return err
+	}
 	return u.postRepository.DeletePost(postId)
 }
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// postPresenter builds post responses with image URLs, counts and the viewer's state.
+// Counts are loaded with one grouped query per kind regardless of the number of posts.
+type postPresenter struct {
+	storageRepository repository.StorageRepository
+	likeRepository    repository.LikeRepository
+	commentRepository repository.CommentRepository
+}
+
+func newPostPresenter(storageRepository repository.StorageRepository, likeRepository repository.LikeRepository, commentRepository repository.CommentRepository) *postPresenter {
+	return &postPresenter{
+		storageRepository: storageRepository,
+		likeRepository:    likeRepository,
+		commentRepository: commentRepository,
+	}
+}
+
+// present converts the posts in order. viewerID may be empty for anonymous requests.
+func (p *postPresenter) present(posts []*ent.Post, viewerID string) ([]models.PostResponse, error) {
+	postResponses := make([]models.PostResponse, len(posts))
+	if len(posts) == 0 {
+		return postResponses, nil
+	}
+
+	postIds := make([]uuid.UUID, len(posts))
+	for i, post := range posts {
+		postIds[i] = post.ID
+	}
+
+	likeCounts, err := p.likeRepository.CountByPosts(postIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count likes: %w", err)
+	}
+	commentCounts, err := p.commentRepository.CountByPosts(postIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+	likedByMe := map[uuid.UUID]bool{}
+	if viewerID != "" {
+		likedByMe, err = p.likeRepository.LikedPostIds(viewerID, postIds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get liked posts: %w", err)
+		}
+	}
+
+	for i, post := range posts {
+		imageURL, thumbnailURL, err := imageURLs(p.storageRepository, post.ImageKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get url for post %v: %w", post.ID, err)
+		}
+		userImageURL := ""
+		if post.Edges.User != nil && post.Edges.User.IconImageKey != "" {
+			userImageURL, err = p.storageRepository.GetUrl(post.Edges.User.IconImageKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get url for user %v: %w", post.Edges.User.ID, err)
+			}
+		}
+		postResponses[i] = models.NewPostResponse(post, imageURL, thumbnailURL, userImageURL, models.PostStats{
+			LikeCount:    likeCounts[post.ID],
+			CommentCount: commentCounts[post.ID],
+			LikedByMe:    likedByMe[post.ID],
+		})
+	}
+	return postResponses, nil
+}
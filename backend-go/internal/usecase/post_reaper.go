@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/labstack/gommon/log"
+)
+
+// PostReaper removes the images of soft-deleted posts. It is kept apart from PostUsecase so that the
+// scheduled job needs only the database and the storage, not the services used when posting.
+type PostReaper struct {
+	postRepository    repository.PostRepository
+	storageRepository repository.StorageRepository
+}
+
+func NewPostReaper(postRepository repository.PostRepository, storageRepository repository.StorageRepository) *PostReaper {
+	return &PostReaper{
+		postRepository:    postRepository,
+		storageRepository: storageRepository,
+	}
+}
+
+// ReapDeletedPosts removes the images of posts soft-deleted longer than gracePeriod ago.
+// It returns the number of images removed. A failed image is counted and retried on a later run,
+// after the posts that have failed fewer times.
+func (u *PostReaper) ReapDeletedPosts(gracePeriod time.Duration, batchSize int) (int, error) {
+	posts, err := u.postRepository.GetDeletedPostsWithImage(time.Now().Add(-gracePeriod), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	reaped := 0
+	for _, post := range posts {
+		if err := deleteImage(u.storageRepository, post.ImageKey); err != nil {
+			log.Errorf("Failed to delete image of post %v (attempt %d): %v", post.ID, post.ImageReapAttempts+1, err)
+			if err := u.postRepository.RecordImageReapFailure(post.ID.String()); err != nil {
+				return reaped, err
+			}
+			continue
+		}
+		if err := u.postRepository.MarkImageDeleted(post.ID.String()); err != nil {
+			return reaped, err
+		}
+		reaped++
+	}
+	return reaped, nil
+}
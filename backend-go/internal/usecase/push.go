@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/labstack/gommon/log"
+)
+
+// pushQueueSize is how many notification events may wait for background delivery before they are delivered inline
+const pushQueueSize = 256
+
+// pushTitle is the title of every push notification; the body tells what happened
+const pushTitle = "Animalia"
+
+var notificationKinds = []notification.Kind{notification.KindLike, notification.KindComment, notification.KindFollow}
+
+var pushBodies = map[notification.Kind]string{
+	notification.KindLike:    "%sさんがあなたの投稿にいいねしました",
+	notification.KindComment: "%sさんがあなたの投稿にコメントしました",
+	notification.KindFollow:  "%sさんがあなたをフォローしました",
+}
+
+// PushDeliveryWorker sends recorded notifications to the recipient's devices, in the background once started.
+// Kinds the recipient opted out of are skipped, and tokens the gateway no longer knows are deleted.
+type PushDeliveryWorker struct {
+	events              chan models.NotificationEvent
+	userRepository      repository.UserRepository
+	pushTokenRepository repository.PushTokenRepository
+	pushSender          repository.PushSender
+}
+
+func NewPushDeliveryWorker(userRepository repository.UserRepository, pushTokenRepository repository.PushTokenRepository, pushSender repository.PushSender) *PushDeliveryWorker {
+	return &PushDeliveryWorker{
+		userRepository:      userRepository,
+		pushTokenRepository: pushTokenRepository,
+		pushSender:          pushSender,
+	}
+}
+
+// Start delivers the enqueued events in a background goroutine. It must not be called where the process
+// is frozen between requests, such as on Lambda, since the queued events would wait for the next request.
+func (w *PushDeliveryWorker) Start() {
+	w.events = make(chan models.NotificationEvent, pushQueueSize)
+	go w.run()
+}
+
+// Enqueue hands the event to the background goroutine. Before Start, or while the queue is full,
+// the event is delivered before returning instead, so that it is never lost.
+func (w *PushDeliveryWorker) Enqueue(event models.NotificationEvent) {
+	if w.events != nil {
+		select {
+		case w.events <- event:
+			return
+		default:
+			log.Warnf("Push queue is full, delivering %s notification for user %s inline", event.Kind, event.RecipientID)
+		}
+	}
+	w.deliverAndLog(event)
+}
+
+func (w *PushDeliveryWorker) run() {
+	for event := range w.events {
+		w.deliverAndLog(event)
+	}
+}
+
+func (w *PushDeliveryWorker) deliverAndLog(event models.NotificationEvent) {
+	if err := w.Deliver(event); err != nil {
+		log.Errorf("Failed to deliver %s notification to user %s: %v", event.Kind, event.RecipientID, err)
+	}
+}
+
+func (w *PushDeliveryWorker) Deliver(event models.NotificationEvent) error {
+	recipient, err := w.userRepository.GetById(event.RecipientID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(recipient.PushOptOuts, event.Kind.String()) {
+		return nil
+	}
+	tokens, err := w.pushTokenRepository.ListByUser(event.RecipientID)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	actor, err := w.userRepository.GetById(event.ActorID)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{
+		"kind":    event.Kind.String(),
+		"actorId": event.ActorID,
+	}
+	if event.PostID != "" {
+		data["postId"] = event.PostID
+	}
+	messages := make([]models.PushMessage, len(tokens))
+	for i, token := range tokens {
+		messages[i] = models.PushMessage{
+			Token: token,
+			Title: pushTitle,
+			Body:  fmt.Sprintf(pushBodies[event.Kind], actor.Name),
+			Data:  data,
+		}
+	}
+
+	invalidTokens, err := w.pushSender.Send(messages)
+	// 送信が途中で失敗しても、判明した無効なトークンは削除する
+	if len(invalidTokens) > 0 {
+		if deleteErr := w.pushTokenRepository.DeleteTokens(invalidTokens); deleteErr != nil {
+			log.Errorf("Failed to delete invalid push tokens: %v", deleteErr)
+		}
+	}
+	return err
+}
+
+type PushUsecase struct {
+	pushTokenRepository repository.PushTokenRepository
+	userRepository      repository.UserRepository
+}
+
+func NewPushUsecase(pushTokenRepository repository.PushTokenRepository, userRepository repository.UserRepository) *PushUsecase {
+	return &PushUsecase{
+		pushTokenRepository: pushTokenRepository,
+		userRepository:      userRepository,
+	}
+}
+
+// RegisterToken accepts Expo push tokens, which look like ExponentPushToken[...] or ExpoPushToken[...]
+func (u *PushUsecase) RegisterToken(userId, token, platform string) error {
+	if !(strings.HasPrefix(token, "ExponentPushToken[") || strings.HasPrefix(token, "ExpoPushToken[")) || !strings.HasSuffix(token, "]") {
+		return fmt.Errorf("%w: %q", models.ErrInvalidPushToken, token)
+	}
+	return u.pushTokenRepository.Register(userId, token, platform)
+}
+
+func (u *PushUsecase) UnregisterToken(userId, token string) error {
+	return u.pushTokenRepository.Unregister(userId, token)
+}
+
+// GetPreferences returns every notification kind with whether it is pushed to the user
+func (u *PushUsecase) GetPreferences(userId string) (models.PushPreferences, error) {
+	user, err := u.userRepository.GetById(userId)
+	if err != nil {
+		return nil, err
+	}
+	preferences := models.PushPreferences{}
+	for _, kind := range notificationKinds {
+		preferences[kind.String()] = !slices.Contains(user.PushOptOuts, kind.String())
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences changes the kinds present in the request and keeps the others
+func (u *PushUsecase) UpdatePreferences(userId string, changes models.PushPreferences) (models.PushPreferences, error) {
+	preferences, err := u.GetPreferences(userId)
+	if err != nil {
+		return nil, err
+	}
+	for kind, enabled := range changes {
+		if err := notification.KindValidator(notification.Kind(kind)); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrInvalidPushPreference, err)
+		}
+		preferences[kind] = enabled
+	}
+
+	optOuts := []string{}
+	for kind, enabled := range preferences {
+		if !enabled {
+			optOuts = append(optOuts, kind)
+		}
+	}
+	slices.Sort(optOuts)
+	if err := u.userRepository.UpdatePushOptOuts(userId, optOuts); err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
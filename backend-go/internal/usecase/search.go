@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/labstack/gommon/log"
+	"github.com/pgvector/pgvector-go"
+)
+
+type SearchUsecase struct {
+	postRepository      repository.PostRepository
+	embeddingRepository repository.EmbeddingRepository
+	presenter           *postPresenter
+}
+
+func NewSearchUsecase(postRepository repository.PostRepository, embeddingRepository repository.EmbeddingRepository, storageRepository repository.StorageRepository, likeRepository repository.LikeRepository, commentRepository repository.CommentRepository) *SearchUsecase {
+	return &SearchUsecase{
+		postRepository:      postRepository,
+		embeddingRepository: embeddingRepository,
+		presenter:           newPostPresenter(storageRepository, likeRepository, commentRepository),
+	}
+}
+
+// SearchPosts ranks posts by how well their image matches the query text, blended with caption matches.
+// When the query cannot be embedded the search falls back to caption matches only.
+func (u *SearchUsecase) SearchPosts(viewerId, query string, limit int) ([]models.PostResponse, error) {
+	query = strings.TrimSpace(query)
+
+	var embedding *pgvector.Vector
+	vector, err := u.embeddingRepository.EmbedText(query)
+	switch {
+	case errors.Is(err, models.ErrEmbeddingNotConfigured):
+	case err != nil:
+		log.Errorf("Failed to embed search query, searching captions only: %v", err)
+	default:
+		embedding = &vector
+	}
+
+	posts, err := u.postRepository.SearchPosts(query, embedding, limit)
+	if err != nil {
+		return nil, err
+	}
+	return u.presenter.present(posts, viewerId)
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/pgvector/pgvector-go"
+)
+
+// searchPostRepository records the arguments of SearchPosts and finds no posts
+type searchPostRepository struct {
+	repository.PostRepository
+	keyword   string
+	embedding *pgvector.Vector
+}
+
+func (r *searchPostRepository) SearchPosts(keyword string, embedding *pgvector.Vector, limit int) ([]*ent.Post, error) {
+	r.keyword = keyword
+	r.embedding = embedding
+	return nil, nil
+}
+
+type fixedEmbeddingRepository struct {
+	vector pgvector.Vector
+	err    error
+}
+
+func (r fixedEmbeddingRepository) EmbedText(text string) (pgvector.Vector, error) {
+	return r.vector, r.err
+}
+
+func TestSearchUsecaseSearchPosts(t *testing.T) {
+	vector := pgvector.NewVector([]float32{1, 0, 0})
+	tests := []struct {
+		name          string
+		embedding     repository.EmbeddingRepository
+		wantEmbedding bool
+	}{
+		{"embedded query", fixedEmbeddingRepository{vector: vector}, true},
+		{"embedding not configured", fixedEmbeddingRepository{err: models.ErrEmbeddingNotConfigured}, false},
+		{"embedding service failed", fixedEmbeddingRepository{err: errors.New("connection refused")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts := &searchPostRepository{}
+			u := NewSearchUsecase(posts, tt.embedding, nil, nil, nil)
+
+			responses, err := u.SearchPosts("", "  dog  ", 10)
+			if err != nil {
+				t.Fatalf("SearchPosts returned error: %v", err)
+			}
+			if len(responses) != 0 {
+				t.Errorf("SearchPosts returned %d posts, want 0", len(responses))
+			}
+			if posts.keyword != "dog" {
+				t.Errorf("keyword = %q, want the trimmed query %q", posts.keyword, "dog")
+			}
+			if got := posts.embedding != nil; got != tt.wantEmbedding {
+				t.Errorf("searched with embedding = %v, want %v", got, tt.wantEmbedding)
+			}
+		})
+	}
+}
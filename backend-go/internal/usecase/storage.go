@@ -1,27 +1,189 @@
 package usecase
 
 import (
+	"fmt"
+	"io"
 	"mime/multipart"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
 )
 
+// uploadURLExpiry is how long a presigned upload URL stays valid
+const uploadURLExpiry = 15 * time.Minute
+
 type StorageUsecase struct {
-	storageRepository repository.StorageRepository
+	storageRepository        repository.StorageRepository
+	imageReferenceRepository repository.ImageReferenceRepository
+}
+
+func NewStorageUsecase(storageRepository repository.StorageRepository, imageReferenceRepository repository.ImageReferenceRepository) *StorageUsecase {
+	return &StorageUsecase{
+		storageRepository:        storageRepository,
+		imageReferenceRepository: imageReferenceRepository,
+	}
+}
+
+// UploadImage processes an image sent through the API and stores it with its variants.
+// It returns the key of the processed original.
+func (u *StorageUsecase) UploadImage(userId string, file *multipart.FileHeader, directory string) (string, error) {
+	if file.Size > models.MaxImageSize {
+		return "", fmt.Errorf("%w: %d bytes", models.ErrUploadTooLarge, file.Size)
+	}
+	src, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(io.LimitReader(src, models.MaxImageSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(data) > models.MaxImageSize {
+		return "", fmt.Errorf("%w: more than %d bytes", models.ErrUploadTooLarge, models.MaxImageSize)
+	}
+	return u.storeImage(data, newImageBaseKey(directory, userId))
 }
 
-func NewStorageUsecase(storageRepository repository.StorageRepository) *StorageUsecase {
-	return &StorageUsecase{storageRepository: storageRepository}
+// CreateUpload issues a key under the user's prefix of the directory and a presigned URL to PUT the image to
+func (u *StorageUsecase) CreateUpload(userId, directory, contentType string) (*models.UploadResponse, error) {
+	if !models.UploadDirectories[directory] {
+		return nil, fmt.Errorf("%w: %q", models.ErrInvalidUploadDirectory, directory)
+	}
+	extension, ok := models.ImageExtensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", models.ErrUnsupportedImageType, contentType)
+	}
+
+	fileKey := models.UploadImageKey(newImageBaseKey(directory, userId), extension)
+	uploadURL, err := u.storageRepository.PresignUpload(fileKey, contentType, uploadURLExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return &models.UploadResponse{
+		Key:         fileKey,
+		UploadURL:   uploadURL,
+		ContentType: contentType,
+		MaxSize:     models.MaxImageSize,
+		ExpiresAt:   time.Now().Add(uploadURLExpiry),
+	}, nil
 }
 
-func (u *StorageUsecase) UploadImage(file *multipart.FileHeader, directory string) (string, error) {
-	return u.storageRepository.UploadImage(file, directory)
+// VerifyUpload checks that the key was issued to the user for the directory, that a valid image was uploaded
+// and that the key has not been used before, then processes the image like UploadImage and returns the key
+// of the processed original. The unprocessed upload is always deleted once it has been examined.
+func (u *StorageUsecase) VerifyUpload(userId, directory, fileKey string) (string, error) {
+	baseKey, ok := models.ImageBaseKey(fileKey)
+	if !ok || !strings.HasPrefix(fileKey, uploadPrefix(directory, userId)) || fileKey != models.UploadImageKey(baseKey, path.Ext(fileKey)) {
+		return "", fmt.Errorf("%w: %q", models.ErrUploadNotOwned, fileKey)
+	}
+
+	object, err := u.storageRepository.StatImage(fileKey)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := u.storageRepository.DeleteImage(fileKey); err != nil {
+			log.Errorf("Failed to delete upload %s: %v", fileKey, err)
+		}
+	}()
+
+	// 署名付き URL の期限内なら同じキーに再アップロードできるため、使用済みの画像を上書きさせない
+	used, err := u.imageReferenceRepository.IsImageReferenced(baseKey)
+	if err != nil {
+		return "", err
+	}
+	if used {
+		return "", fmt.Errorf("%w: %q", models.ErrUploadAlreadyUsed, fileKey)
+	}
+
+	if _, ok := models.ImageExtensions[object.ContentType]; !ok {
+		return "", fmt.Errorf("%w: %q", models.ErrUnsupportedImageType, object.ContentType)
+	}
+	if object.Size > models.MaxImageSize {
+		return "", fmt.Errorf("%w: %d bytes", models.ErrUploadTooLarge, object.Size)
+	}
+
+	data, err := u.storageRepository.GetObject(fileKey)
+	if err != nil {
+		return "", err
+	}
+	return u.storeImage(data, baseKey)
+}
+
+// storeImage validates, strips and resizes the image and stores the original with its variants
+func (u *StorageUsecase) storeImage(data []byte, baseKey string) (string, error) {
+	images, err := processImage(data, baseKey)
+	if err != nil {
+		return "", err
+	}
+	for i, image := range images {
+		if err := u.storageRepository.PutObject(image.key, image.contentType, image.data); err != nil {
+			// 保存済みの画像を残さない
+			for _, stored := range images[:i] {
+				if err := u.storageRepository.DeleteImage(stored.key); err != nil {
+					log.Errorf("Failed to delete image %s: %v", stored.key, err)
+				}
+			}
+			return "", err
+		}
+	}
+	return images[0].key, nil
 }
 
 func (u *StorageUsecase) GetUrl(fileKey string) (string, error) {
 	return u.storageRepository.GetUrl(fileKey)
 }
 
+// GetUrls returns the URLs of the image and of its thumbnail
+func (u *StorageUsecase) GetUrls(fileKey string) (string, string, error) {
+	return imageURLs(u.storageRepository, fileKey)
+}
+
+// DeleteImage deletes the image together with its variants
 func (u *StorageUsecase) DeleteImage(fileKey string) error {
-	return u.storageRepository.DeleteImage(fileKey)
+	return deleteImage(u.storageRepository, fileKey)
+}
+
+func uploadPrefix(directory, userId string) string {
+	return directory + "/" + userId + "/"
+}
+
+func newImageBaseKey(directory, userId string) string {
+	return uploadPrefix(directory, userId) + uuid.New().String()
+}
+
+// imageURLs returns the URLs of the image and of its thumbnail.
+// Images without variants use the URL of the image for both.
+func imageURLs(storageRepository repository.StorageRepository, fileKey string) (string, string, error) {
+	imageURL, err := storageRepository.GetUrl(fileKey)
+	if err != nil {
+		return "", "", err
+	}
+	thumbnailKey := models.ThumbnailKey(fileKey)
+	if thumbnailKey == fileKey {
+		return imageURL, imageURL, nil
+	}
+	thumbnailURL, err := storageRepository.GetUrl(thumbnailKey)
+	if err != nil {
+		return "", "", err
+	}
+	return imageURL, thumbnailURL, nil
+}
+
+// deleteImage deletes the image and its variants, continuing past failures and returning the first one
+func deleteImage(storageRepository repository.StorageRepository, fileKey string) error {
+	var firstErr error
+	for _, key := range models.ImageKeys(fileKey) {
+		if err := storageRepository.DeleteImage(key); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
 }
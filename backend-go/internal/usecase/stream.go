@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"encoding/json"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// Streamer publishes comment, like and notification events to the clients watching them.
+// Failures are only logged, since the action itself has already succeeded.
+type Streamer struct {
+	eventHub repository.EventHub
+}
+
+func NewStreamer(eventHub repository.EventHub) *Streamer {
+	return &Streamer{
+		eventHub: eventHub,
+	}
+}
+
+func (s *Streamer) CommentCreated(postId string, comment models.CommentResponse) {
+	s.publish(models.PostChannel(postId), models.StreamEventCommentCreated, comment)
+}
+
+func (s *Streamer) CommentUpdated(postId string, comment models.CommentResponse) {
+	s.publish(models.PostChannel(postId), models.StreamEventCommentUpdated, comment)
+}
+
+func (s *Streamer) CommentDeleted(postId string, commentId uuid.UUID) {
+	s.publish(models.PostChannel(postId), models.StreamEventCommentDeleted, models.CommentDeletedEvent{ID: commentId})
+}
+
+func (s *Streamer) LikeCount(postId uuid.UUID, count int) {
+	s.publish(models.PostChannel(postId.String()), models.StreamEventLikeCount, models.LikeCountEvent{PostID: postId, Count: count})
+}
+
+func (s *Streamer) Notification(userId string, event models.NotificationStreamEvent) {
+	s.publish(models.UserChannel(userId), models.StreamEventNotification, event)
+}
+
+func (s *Streamer) publish(channel, eventType string, data interface{}) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		log.Errorf("Failed to encode %s event: %v", eventType, err)
+		return
+	}
+	if err := s.eventHub.Publish(channel, models.StreamEvent{Type: eventType, Data: encoded}); err != nil {
+		log.Errorf("Failed to publish %s event to %s: %v", eventType, channel, err)
+	}
+}
+
+type StreamUsecase struct {
+	eventHub       repository.EventHub
+	postRepository repository.PostRepository
+}
+
+func NewStreamUsecase(eventHub repository.EventHub, postRepository repository.PostRepository) *StreamUsecase {
+	return &StreamUsecase{
+		eventHub:       eventHub,
+		postRepository: postRepository,
+	}
+}
+
+// SubscribePost returns the comment and like events of the post, or a not found error when the post does not exist
+func (u *StreamUsecase) SubscribePost(postId string) (<-chan models.StreamEvent, func(), error) {
+	if _, err := u.postRepository.GetById(postId); err != nil {
+		return nil, nil, err
+	}
+	events, unsubscribe := u.eventHub.Subscribe(models.PostChannel(postId))
+	return events, unsubscribe, nil
+}
+
+// SubscribeUser returns the notification events of the user
+func (u *StreamUsecase) SubscribeUser(userId string) (<-chan models.StreamEvent, func()) {
+	return u.eventHub.Subscribe(models.UserChannel(userId))
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+const (
+	// trendingWindow is how far back posts count towards trending tags
+	trendingWindow = 7 * 24 * time.Hour
+	// trendingHalfLife is how long it takes for a post to count half as much
+	trendingHalfLife = 24 * time.Hour
+)
+
+type TagUsecase struct {
+	tagRepository  repository.TagRepository
+	postRepository repository.PostRepository
+	presenter      *postPresenter
+}
+
+func NewTagUsecase(tagRepository repository.TagRepository, postRepository repository.PostRepository, storageRepository repository.StorageRepository, likeRepository repository.LikeRepository, commentRepository repository.CommentRepository) *TagUsecase {
+	return &TagUsecase{
+		tagRepository:  tagRepository,
+		postRepository: postRepository,
+		presenter:      newPostPresenter(storageRepository, likeRepository, commentRepository),
+	}
+}
+
+// GetPosts returns one page of the posts with the tag, newest first, and the cursor of the next page.
+// The name is normalized like the hashtags of captions, so "#CatNap" finds the posts tagged "#catnap".
+func (u *TagUsecase) GetPosts(viewerId, name string, cursor *models.Cursor, limit int) ([]models.PostResponse, *models.Cursor, error) {
+	tagName, err := models.NormalizeTag(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	posts, err := u.postRepository.GetByTag(tagName, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *models.Cursor
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[len(posts)-1]
+		nextCursor = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	postResponses, err := u.presenter.present(posts, viewerId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return postResponses, nextCursor, nil
+}
+
+// GetTrending ranks the tags used in the last week, favoring recent posts
+func (u *TagUsecase) GetTrending(limit int) ([]models.TrendingTag, error) {
+	return u.tagRepository.Trending(time.Now().Add(-trendingWindow), trendingHalfLife, limit)
+}
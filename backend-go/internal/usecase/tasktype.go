@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// ErrInvalidTaskType is returned when a catalog entry fails validation
+var ErrInvalidTaskType = errors.New("invalid task type")
+
+type TaskTypeUsecase struct {
+	taskTypeRepository repository.TaskTypeRepository
+}
+
+func NewTaskTypeUsecase(taskTypeRepository repository.TaskTypeRepository) *TaskTypeUsecase {
+	return &TaskTypeUsecase{
+		taskTypeRepository: taskTypeRepository,
+	}
+}
+
+func (u *TaskTypeUsecase) List() ([]models.TaskTypeResponse, error) {
+	taskTypes, err := u.taskTypeRepository.List(true)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]models.TaskTypeResponse, len(taskTypes))
+	for i, taskType := range taskTypes {
+		responses[i] = models.NewTaskTypeResponse(taskType)
+	}
+	return responses, nil
+}
+
+func (u *TaskTypeUsecase) Create(input models.TaskTypeInput) (*models.TaskTypeResponse, error) {
+	if err := validateTaskType(input); err != nil {
+		return nil, err
+	}
+	taskType, err := u.taskTypeRepository.Create(input)
+	if err != nil {
+		return nil, err
+	}
+	resp := models.NewTaskTypeResponse(taskType)
+	return &resp, nil
+}
+
+func (u *TaskTypeUsecase) Update(id int, input models.TaskTypeInput) (*models.TaskTypeResponse, error) {
+	if err := validateTaskType(input); err != nil {
+		return nil, err
+	}
+	taskType, err := u.taskTypeRepository.Update(id, input)
+	if err != nil {
+		return nil, err
+	}
+	resp := models.NewTaskTypeResponse(taskType)
+	return &resp, nil
+}
+
+func (u *TaskTypeUsecase) Deactivate(id int) error {
+	return u.taskTypeRepository.Deactivate(id)
+}
+
+func validateTaskType(input models.TaskTypeInput) error {
+	if input.Type == "" {
+		return fmt.Errorf("%w: type is required", ErrInvalidTaskType)
+	}
+	if input.Weight < 1 {
+		return fmt.Errorf("%w: weight must be at least 1", ErrInvalidTaskType)
+	}
+	if models.LocalizedPrompt(input.Prompt, models.DefaultLanguage) == "" {
+		return fmt.Errorf("%w: prompt in %q is required", ErrInvalidTaskType, models.DefaultLanguage)
+	}
+	for _, petType := range input.PetTypes {
+		if err := pet.TypeValidator(pet.Type(petType)); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidTaskType, err)
+		}
+	}
+	return nil
+}
+
+// taskTypesByType indexes the catalog by its type key
+func taskTypesByType(taskTypes []*ent.TaskType) map[string]*ent.TaskType {
+	byType := make(map[string]*ent.TaskType, len(taskTypes))
+	for _, taskType := range taskTypes {
+		byType[string(taskType.Type)] = taskType
+	}
+	return byType
+}
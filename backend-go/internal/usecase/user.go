@@ -1,9 +1,12 @@
 package usecase
 
 import (
+	"errors"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 )
 
@@ -13,15 +16,19 @@ type UserUsecase struct {
 	postRepository           repository.PostRepository
 	petRepository            repository.PetRepository
 	followRelationRepository repository.FollowRelationRepository
+	postPresenter            *postPresenter
+	notifier                 *Notifier
 }
 
-func NewUserUsecase(userRepository repository.UserRepository, storageRepository repository.StorageRepository, postRepository repository.PostRepository, petRepository repository.PetRepository, followRelationRepository repository.FollowRelationRepository) *UserUsecase {
+func NewUserUsecase(userRepository repository.UserRepository, storageRepository repository.StorageRepository, postRepository repository.PostRepository, petRepository repository.PetRepository, followRelationRepository repository.FollowRelationRepository, likeRepository repository.LikeRepository, commentRepository repository.CommentRepository, notifier *Notifier) *UserUsecase {
 	return &UserUsecase{
 		userRepository:           userRepository,
 		storageRepository:        storageRepository,
 		postRepository:           postRepository,
 		petRepository:            petRepository,
 		followRelationRepository: followRelationRepository,
+		postPresenter:            newPostPresenter(storageRepository, likeRepository, commentRepository),
+		notifier:                 notifier,
 	}
 }
 
@@ -37,6 +44,65 @@ func (u *UserUsecase) GetById(id string) (*ent.User, error) {
 	return u.userRepository.GetById(id)
 }
 
+// profilePostPreviewLimit is the number of recent posts shown on a profile
+const profilePostPreviewLimit = 12
+
+// GetProfile returns the public profile of a user as seen by the viewer, who may be empty for anonymous requests
+func (u *UserUsecase) GetProfile(viewerId, id string) (models.UserProfileResponse, error) {
+	user, err := u.userRepository.GetById(id)
+	if err != nil {
+		return models.UserProfileResponse{}, err
+	}
+
+	iconURL, err := u.iconURL(user)
+	if err != nil {
+		log.Errorf("Failed to get url: %v", err)
+		return models.UserProfileResponse{}, err
+	}
+
+	followerCount, err := u.followRelationRepository.CountFollowers(id)
+	if err != nil {
+		return models.UserProfileResponse{}, err
+	}
+	followingCount, err := u.followRelationRepository.CountFollows(id)
+	if err != nil {
+		return models.UserProfileResponse{}, err
+	}
+
+	posts, err := u.postRepository.GetRecentPostsByUser(user.ID, profilePostPreviewLimit)
+	if err != nil {
+		log.Errorf("Failed to get posts by user: %v", err)
+		return models.UserProfileResponse{}, err
+	}
+	postResponses, err := u.postPresenter.present(posts, viewerId)
+	if err != nil {
+		log.Errorf("Failed to convert posts: %v", err)
+		return models.UserProfileResponse{}, err
+	}
+
+	pets, err := u.petRepository.GetByOwner(id)
+	if err != nil {
+		return models.UserProfileResponse{}, err
+	}
+	petResponses := make([]models.PetResponse, len(pets))
+	for i, pet := range pets {
+		imageURL, thumbnailURL, err := imageURLs(u.storageRepository, pet.ImageKey)
+		if err != nil {
+			log.Errorf("Failed to get url: %v", err)
+			return models.UserProfileResponse{}, err
+		}
+		petResponses[i] = models.NewPetResponse(pet, imageURL, thumbnailURL)
+	}
+
+	return models.UserProfileResponse{
+		PublicUserResponse: models.NewPublicUserResponse(user, iconURL),
+		FollowerCount:      followerCount,
+		FollowingCount:     followingCount,
+		Posts:              postResponses,
+		Pets:               petResponses,
+	}, nil
+}
+
 func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
 	user, err := u.userRepository.FindByEmail(email)
 	if err != nil {
@@ -59,14 +125,10 @@ func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
 		log.Errorf("Failed to get posts by user: %v", err)
 		return models.UserResponse{}, err
 	}
-	postResponses := make([]models.PostResponse, len(posts))
-	for i, post := range posts {
-		imageURL, err := u.storageRepository.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get url: %v", err)
-			return models.UserResponse{}, err
-		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, iconURL)
+	postResponses, err := u.postPresenter.present(posts, user.ID.String())
+	if err != nil {
+		log.Errorf("Failed to convert posts: %v", err)
+		return models.UserResponse{}, err
 	}
 
 	pets, err := u.petRepository.GetByOwner(user.ID.String())
@@ -75,12 +137,12 @@ func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
 	}
 	petResponses := make([]models.PetResponse, len(pets))
 	for i, pet := range pets {
-		imageURL, err := u.storageRepository.GetUrl(pet.ImageKey)
+		imageURL, thumbnailURL, err := imageURLs(u.storageRepository, pet.ImageKey)
 		if err != nil {
 			log.Errorf("Failed to get url: %v", err)
 			return models.UserResponse{}, err
 		}
-		petResponses[i] = models.NewPetResponse(pet, imageURL)
+		petResponses[i] = models.NewPetResponse(pet, imageURL, thumbnailURL)
 	}
 
 	userResponse := models.NewUserResponse(user, iconURL, postResponses, petResponses)
@@ -88,8 +150,42 @@ func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
 	return userResponse, nil
 }
 
+// ErrSelfFollow is returned when a user tries to follow themselves
+var ErrSelfFollow = errors.New("cannot follow yourself")
+
+// Follow is idempotent: following a user twice keeps a single relation and notifies only once
 func (u *UserUsecase) Follow(followerId string, followedId string) error {
-	return u.userRepository.Follow(followerId, followedId)
+	if followerId == followedId {
+		return ErrSelfFollow
+	}
+	created, err := u.userRepository.Follow(followerId, followedId)
+	if err != nil {
+		return err
+	}
+	if created {
+		u.notifier.NotifyFollow(followerId, followedId)
+	}
+	return nil
+}
+
+func (u *UserUsecase) Unfollow(followerId string, followedId string) error {
+	return u.userRepository.Unfollow(followerId, followedId)
+}
+
+// Relationship reports whether the viewer follows the user and whether the user follows the viewer
+func (u *UserUsecase) Relationship(viewerId string, userId string) (models.RelationshipResponse, error) {
+	following, err := u.followRelationRepository.IsFollowing(viewerId, userId)
+	if err != nil {
+		return models.RelationshipResponse{}, err
+	}
+	followedBy, err := u.followRelationRepository.IsFollowing(userId, viewerId)
+	if err != nil {
+		return models.RelationshipResponse{}, err
+	}
+	return models.RelationshipResponse{
+		Following:  following,
+		FollowedBy: followedBy,
+	}, nil
 }
 
 func (u *UserUsecase) FollowsCount(id string) (int, error) {
@@ -100,10 +196,69 @@ func (u *UserUsecase) FollowerCount(id string) (int, error) {
 	return u.followRelationRepository.CountFollowers(id)
 }
 
-func (u *UserUsecase) FollowingUsers(id string) ([]*ent.User, error) {
-	return u.followRelationRepository.Followings(id)
+// FollowingUsers returns one page of the users followed by the user, and the cursor of the next page
+func (u *UserUsecase) FollowingUsers(viewerId, id string, cursor *models.Cursor, limit int) ([]models.FollowUserResponse, *models.Cursor, error) {
+	relations, err := u.followRelationRepository.Followings(id, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u.toFollowUserPage(viewerId, relations, limit, func(relation *ent.FollowRelation) *ent.User {
+		return relation.Edges.To
+	})
+}
+
+// Followers returns one page of the users following the user, and the cursor of the next page
+func (u *UserUsecase) Followers(viewerId, id string, cursor *models.Cursor, limit int) ([]models.FollowUserResponse, *models.Cursor, error) {
+	relations, err := u.followRelationRepository.Followers(id, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u.toFollowUserPage(viewerId, relations, limit, func(relation *ent.FollowRelation) *ent.User {
+		return relation.Edges.From
+	})
 }
 
-func (u *UserUsecase) Followers(id string) ([]*ent.User, error) {
-	return u.followRelationRepository.Followers(id)
+// toFollowUserPage trims the extra relation fetched to detect the next page and builds public profiles
+func (u *UserUsecase) toFollowUserPage(viewerId string, relations []*ent.FollowRelation, limit int, userOf func(*ent.FollowRelation) *ent.User) ([]models.FollowUserResponse, *models.Cursor, error) {
+	var nextCursor *models.Cursor
+	if len(relations) > limit {
+		relations = relations[:limit]
+		last := relations[len(relations)-1]
+		nextCursor = &models.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	userIds := make([]uuid.UUID, len(relations))
+	for i, relation := range relations {
+		userIds[i] = userOf(relation).ID
+	}
+	followedByMe := map[uuid.UUID]bool{}
+	if viewerId != "" && len(userIds) > 0 {
+		var err error
+		followedByMe, err = u.followRelationRepository.FollowingIds(viewerId, userIds)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	responses := make([]models.FollowUserResponse, len(relations))
+	for i, relation := range relations {
+		user := userOf(relation)
+		iconURL, err := u.iconURL(user)
+		if err != nil {
+			return nil, nil, err
+		}
+		responses[i] = models.FollowUserResponse{
+			PublicUserResponse: models.NewPublicUserResponse(user, iconURL),
+			FollowedByMe:       followedByMe[user.ID],
+		}
+	}
+	return responses, nextCursor, nil
+}
+
+// iconURL returns the presigned URL of the user's icon, or an empty string when no icon is set
+func (u *UserUsecase) iconURL(user *ent.User) (string, error) {
+	if user.IconImageKey == "" {
+		return "", nil
+	}
+	return u.storageRepository.GetUrl(user.IconImageKey)
 }